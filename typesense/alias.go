@@ -2,6 +2,7 @@ package typesense
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/typesense/typesense-go/v2/typesense/api"
 )
@@ -9,6 +10,9 @@ import (
 // AliasInterface is a type for Alias API operations
 type AliasInterface interface {
 	Retrieve(ctx context.Context) (*api.CollectionAlias, error)
+	// Get retrieves the alias, reporting found=false instead of an error
+	// when the alias does not exist
+	Get(ctx context.Context) (*api.CollectionAlias, bool, error)
 	Delete(ctx context.Context) (*api.CollectionAlias, error)
 }
 
@@ -28,6 +32,20 @@ func (a *alias) Retrieve(ctx context.Context) (*api.CollectionAlias, error) {
 	return response.JSON200, nil
 }
 
+func (a *alias) Get(ctx context.Context) (*api.CollectionAlias, bool, error) {
+	response, err := a.apiClient.GetAliasWithResponse(ctx, a.name)
+	if err != nil {
+		return nil, false, err
+	}
+	if response.StatusCode() == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if response.JSON200 == nil {
+		return nil, false, &HTTPError{Status: response.StatusCode(), Body: response.Body}
+	}
+	return response.JSON200, true, nil
+}
+
 func (a *alias) Delete(ctx context.Context) (*api.CollectionAlias, error) {
 	response, err := a.apiClient.DeleteAliasWithResponse(ctx, a.name)
 	if err != nil {
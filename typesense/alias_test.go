@@ -69,6 +69,77 @@ func TestCollectionAliasRetrieveOnHttpStatusErrorCodeReturnsError(t *testing.T)
 	assert.NotNil(t, err)
 }
 
+func TestCollectionAliasGet(t *testing.T) {
+	expectedResult := createNewCollectionAlias("collection", "collection_alias")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+	mockedResult := createNewCollectionAlias("collection", "collection_alias")
+
+	mockAPIClient.EXPECT().
+		GetAliasWithResponse(gomock.Not(gomock.Nil()), "collection_alias").
+		Return(&api.GetAliasResponse{
+			HTTPResponse: &http.Response{
+				StatusCode: 200,
+			},
+			JSON200: mockedResult,
+		}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	result, found, err := client.Alias("collection_alias").Get(context.Background())
+
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestCollectionAliasGetWithMissingAliasReturnsNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		GetAliasWithResponse(gomock.Not(gomock.Nil()), "collection_alias").
+		Return(&api.GetAliasResponse{
+			HTTPResponse: &http.Response{
+				StatusCode: 404,
+			},
+			Body:    []byte(`{"message": "Not Found"}`),
+			JSON404: &api.ApiResponse{Message: "Not Found"},
+		}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	result, found, err := client.Alias("collection_alias").Get(context.Background())
+
+	assert.Nil(t, err)
+	assert.False(t, found)
+	assert.Nil(t, result)
+}
+
+func TestCollectionAliasGetOnHttpStatusErrorCodeReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		GetAliasWithResponse(gomock.Not(gomock.Nil()), "collection_alias").
+		Return(&api.GetAliasResponse{
+			HTTPResponse: &http.Response{
+				StatusCode: 500,
+			},
+			Body: []byte("Internal Server error"),
+		}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, found, err := client.Alias("collection_alias").Get(context.Background())
+	assert.False(t, found)
+	assert.NotNil(t, err)
+}
+
 func TestCollectionAliasDelete(t *testing.T) {
 	expectedResult := createNewCollectionAlias("collection", "collection_alias")
 
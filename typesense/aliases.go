@@ -2,6 +2,8 @@ package typesense
 
 import (
 	"context"
+	"sort"
+	"sync"
 
 	"github.com/typesense/typesense-go/v2/typesense/api"
 )
@@ -10,6 +12,11 @@ import (
 type AliasesInterface interface {
 	Upsert(ctx context.Context, aliasName string, aliasSchema *api.CollectionAliasSchema) (*api.CollectionAlias, error)
 	Retrieve(ctx context.Context) ([]*api.CollectionAlias, error)
+	// UpsertBatch points each alias of the aliases map to its collection. The server
+	// has no batch endpoint, so the aliases are upserted concurrently to keep the
+	// swap window short. The results and errors are ordered by alias name and
+	// errors[i] is nil when the upsert of results[i] succeeded.
+	UpsertBatch(ctx context.Context, aliases map[string]string) ([]api.CollectionAlias, []error)
 }
 
 // aliases is internal implementation of AliasesInterface
@@ -39,3 +46,30 @@ func (a *aliases) Retrieve(ctx context.Context) ([]*api.CollectionAlias, error)
 	}
 	return response.JSON200.Aliases, nil
 }
+
+func (a *aliases) UpsertBatch(ctx context.Context, aliases map[string]string) ([]api.CollectionAlias, []error) {
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	results := make([]api.CollectionAlias, len(names))
+	errs := make([]error, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			alias, err := a.Upsert(ctx, name, &api.CollectionAliasSchema{CollectionName: aliases[name]})
+			if err != nil {
+				results[i] = api.CollectionAlias{Name: &name, CollectionName: aliases[name]}
+				errs[i] = err
+				return
+			}
+			results[i] = *alias
+		}(i, name)
+	}
+	wg.Wait()
+	return results, errs
+}
@@ -155,3 +155,61 @@ func TestCollectionAliasesRetrieveOnHttpStatusErrorCodeReturnsError(t *testing.T
 	_, err := client.Aliases().Retrieve(context.Background())
 	assert.NotNil(t, err)
 }
+
+func TestCollectionAliasesUpsertBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		UpsertAliasWithResponse(gomock.Not(gomock.Nil()), "companies",
+			api.UpsertAliasJSONRequestBody(api.CollectionAliasSchema{CollectionName: "companies_v2"})).
+		Return(&api.UpsertAliasResponse{
+			JSON200: createNewCollectionAlias("companies_v2", "companies"),
+		}, nil).
+		Times(1)
+	mockAPIClient.EXPECT().
+		UpsertAliasWithResponse(gomock.Not(gomock.Nil()), "products",
+			api.UpsertAliasJSONRequestBody(api.CollectionAliasSchema{CollectionName: "products_v2"})).
+		Return(&api.UpsertAliasResponse{
+			HTTPResponse: &http.Response{
+				StatusCode: 404,
+			},
+			Body: []byte("Not Found"),
+		}, nil).
+		Times(1)
+	mockAPIClient.EXPECT().
+		UpsertAliasWithResponse(gomock.Not(gomock.Nil()), "employees",
+			api.UpsertAliasJSONRequestBody(api.CollectionAliasSchema{CollectionName: "employees_v2"})).
+		Return(nil, errors.New("failed request")).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	results, errs := client.Aliases().UpsertBatch(context.Background(), map[string]string{
+		"products":  "products_v2",
+		"companies": "companies_v2",
+		"employees": "employees_v2",
+	})
+
+	assert.Equal(t, []api.CollectionAlias{
+		*createNewCollectionAlias("companies_v2", "companies"),
+		*createNewCollectionAlias("employees_v2", "employees"),
+		*createNewCollectionAlias("products_v2", "products"),
+	}, results)
+	assert.Equal(t, []error{
+		nil,
+		errors.New("failed request"),
+		&HTTPError{Status: 404, Body: []byte("Not Found")},
+	}, errs)
+}
+
+func TestCollectionAliasesUpsertBatchWithNoAliases(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	results, errs := client.Aliases().UpsertBatch(context.Background(), map[string]string{})
+	assert.Empty(t, results)
+	assert.Empty(t, errs)
+}
@@ -77,6 +77,14 @@ func WithGoBreakerOnStateChange(onStateChange GoBreakerOnStateChangeFunc) GoBrea
 	}
 }
 
+// ConsecutiveFailures returns a ReadyToTrip function that trips the CircuitBreaker
+// after the given number of consecutive failures.
+func ConsecutiveFailures(failures uint32) GoBreakerReadyToTripFunc {
+	return func(counts gobreaker.Counts) bool {
+		return counts.ConsecutiveFailures >= failures
+	}
+}
+
 func DefaultReadyToTrip(counts gobreaker.Counts) bool {
 	return counts.Requests > 100 &&
 		(float64(counts.TotalFailures)/float64(counts.Requests)) > 0.5
@@ -112,3 +120,8 @@ func (gb *GoBreaker) Execute(req func() error) error {
 	})
 	return err
 }
+
+// State returns the current state of the CircuitBreaker.
+func (gb *GoBreaker) State() gobreaker.State {
+	return gb.cb.State()
+}
@@ -130,3 +130,17 @@ func TestGoBreakerSettingsOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestGoBreakerConsecutiveFailures(t *testing.T) {
+	gb := NewGoBreaker(WithGoBreakerReadyToTrip(ConsecutiveFailures(2)))
+	fail := func() error { return errors.New("execute error") }
+
+	assert.Error(t, gb.Execute(fail))
+	assert.NoError(t, gb.Execute(func() error { return nil }))
+	assert.Error(t, gb.Execute(fail))
+	assert.Equal(t, gobreaker.StateClosed, gb.State())
+
+	assert.Error(t, gb.Execute(fail))
+	assert.Equal(t, gobreaker.StateOpen, gb.State())
+	assert.ErrorIs(t, gb.Execute(func() error { return nil }), gobreaker.ErrOpenState)
+}
@@ -7,12 +7,56 @@ import (
 
 const APIKeyHeader = "X-TYPESENSE-API-KEY" // #nosec G101
 
+type apiKeyContextKey struct{}
+
+// ContextWithAPIKey returns a copy of ctx that overrides the client-wide API key
+// for the requests made with it, e.g. to use a scoped search key per request.
+func ContextWithAPIKey(ctx context.Context, apiKey string) context.Context {
+	return context.WithValue(ctx, apiKeyContextKey{}, apiKey)
+}
+
+func apiKeyFromContext(ctx context.Context) (string, bool) {
+	apiKey, ok := ctx.Value(apiKeyContextKey{}).(string)
+	return apiKey, ok
+}
+
 func WithAPIKey(apiKey string) ClientOption {
 	return func(c *Client) error {
-		c.RequestEditors = []RequestEditorFn{func(_ context.Context, req *http.Request) error {
+		c.RequestEditors = append(c.RequestEditors, func(ctx context.Context, req *http.Request) error {
+			if override, ok := apiKeyFromContext(ctx); ok {
+				req.Header.Set(APIKeyHeader, override)
+				return nil
+			}
 			req.Header.Add(APIKeyHeader, apiKey)
 			return nil
-		}}
+		})
+		return nil
+	}
+}
+
+// WithDefaultHeaders sets headers sent with every request. The API key header
+// cannot be set this way and is ignored, use WithAPIKey instead.
+func WithDefaultHeaders(headers map[string]string) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, func(_ context.Context, req *http.Request) error {
+			for name, value := range headers {
+				if http.CanonicalHeaderKey(name) == http.CanonicalHeaderKey(APIKeyHeader) {
+					continue
+				}
+				req.Header.Set(name, value)
+			}
+			return nil
+		})
+		return nil
+	}
+}
+
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, func(_ context.Context, req *http.Request) error {
+			req.Header.Set("User-Agent", userAgent)
+			return nil
+		})
 		return nil
 	}
 }
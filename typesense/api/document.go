@@ -0,0 +1,217 @@
+package api
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// DocumentMarshaler is implemented by types that convert themselves into a
+// Typesense document. Documents implementing it are encoded with
+// MarshalDocument instead of being serialized directly when they are indexed.
+type DocumentMarshaler interface {
+	MarshalDocument() (map[string]interface{}, error)
+}
+
+// MarshalDocument is the default document encoder. It converts the struct v into
+// a document map using the same field names as encoding/json, with time.Time
+// values converted to unix timestamps (int64) so they can be indexed as int64
+// fields, matching the schema produced by SchemaFromStruct. Fields of embedded
+// structs are promoted and nested structs are converted to objects. Values
+// implementing DocumentMarshaler are encoded with their own MarshalDocument, values
+// implementing json.Marshaler or encoding.TextMarshaler keep their JSON encoding and
+// the string option of json tags is honored.
+func MarshalDocument(v any) (map[string]interface{}, error) {
+	if marshaler, ok := v.(DocumentMarshaler); ok {
+		return marshaler.MarshalDocument()
+	}
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a struct, got %T", v)
+	}
+	document := map[string]interface{}{}
+	if err := encodeStructFields(document, value); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+func encodeStructFields(document map[string]interface{}, value reflect.Value) error {
+	t := value.Type()
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+		jsonName, jsonOptions, _ := strings.Cut(structField.Tag.Get("json"), ",")
+		if jsonName == "-" && jsonOptions == "" {
+			continue
+		}
+
+		fieldValue := value.Field(i)
+		if structField.Anonymous && jsonName == "" {
+			embedded := fieldValue
+			for embedded.Kind() == reflect.Ptr {
+				if embedded.IsNil() {
+					break
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Ptr {
+				// like encoding/json, nil embedded pointers contribute no fields
+				continue
+			}
+			if embedded.Kind() == reflect.Struct && embedded.Type() != timeType {
+				if err := encodeStructFields(document, embedded); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		if jsonName == "" {
+			jsonName = structField.Name
+		}
+		if strings.Contains(","+jsonOptions+",", ",omitempty,") && isEmptyValue(fieldValue) {
+			continue
+		}
+		encoded, err := encodeDocumentValue(fieldValue)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", structField.Name, err)
+		}
+		if strings.Contains(","+jsonOptions+",", ",string,") && isQuotableValue(fieldValue) && jsonEncodingMarshaler(fieldValue) == nil {
+			// like encoding/json, the string option encodes scalars as JSON strings
+			quoted, err := json.Marshal(encoded)
+			if err != nil {
+				return fmt.Errorf("field %s: %w", structField.Name, err)
+			}
+			encoded = string(quoted)
+		}
+		document[jsonName] = encoded
+	}
+	return nil
+}
+
+func encodeDocumentValue(value reflect.Value) (interface{}, error) {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil, nil
+		}
+		if marshaler, ok := value.Interface().(DocumentMarshaler); ok {
+			return marshaler.MarshalDocument()
+		}
+		value = value.Elem()
+	}
+	if marshaler, ok := value.Interface().(DocumentMarshaler); ok {
+		return marshaler.MarshalDocument()
+	}
+	if value.Type() != timeType {
+		// values with their own JSON or text encoding, e.g. enums or UUIDs, keep it
+		if marshaler := jsonEncodingMarshaler(value); marshaler != nil {
+			return marshaler, nil
+		}
+	}
+
+	switch {
+	case value.Type() == timeType:
+		return value.Interface().(time.Time).Unix(), nil
+	case value.Kind() == reflect.Struct:
+		nested := map[string]interface{}{}
+		if err := encodeStructFields(nested, value); err != nil {
+			return nil, err
+		}
+		return nested, nil
+	case value.Kind() == reflect.Map && value.Type().Key().Kind() == reflect.String:
+		if value.IsNil() {
+			return nil, nil
+		}
+		nested := make(map[string]interface{}, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			encoded, err := encodeDocumentValue(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			nested[iter.Key().String()] = encoded
+		}
+		return nested, nil
+	case value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8:
+		// byte slices are left to encoding/json, which encodes them as base64 strings
+		return value.Interface(), nil
+	case value.Kind() == reflect.Slice || value.Kind() == reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			return nil, nil
+		}
+		items := make([]interface{}, value.Len())
+		for i := range items {
+			encoded, err := encodeDocumentValue(value.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			items[i] = encoded
+		}
+		return items, nil
+	default:
+		// named types like enums keep their own JSON encoding
+		return value.Interface(), nil
+	}
+}
+
+// jsonEncodingMarshaler returns the json.Marshaler or encoding.TextMarshaler that
+// encoding/json would use to encode value, or nil if it has none
+func jsonEncodingMarshaler(value reflect.Value) interface{} {
+	switch value.Interface().(type) {
+	case json.Marshaler, encoding.TextMarshaler:
+		return value.Interface()
+	}
+	if value.CanAddr() {
+		switch value.Addr().Interface().(type) {
+		case json.Marshaler, encoding.TextMarshaler:
+			return value.Addr().Interface()
+		}
+	}
+	return nil
+}
+
+// isQuotableValue reports whether the json string option applies to v: a bool,
+// number or string, or a non-nil pointer to one
+func isQuotableValue(v reflect.Value) bool {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
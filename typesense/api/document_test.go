@@ -0,0 +1,185 @@
+package api
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type documentStatus int
+
+const (
+	documentStatusDraft documentStatus = iota
+	documentStatusPublished
+)
+
+func (s documentStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case documentStatusDraft:
+		return []byte("draft"), nil
+	case documentStatusPublished:
+		return []byte("published"), nil
+	}
+	return nil, errors.New("unknown status")
+}
+
+type documentCategory string
+
+type documentAuthor struct {
+	Name     string    `json:"name"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+type DocumentTimestamps struct {
+	CreatedAt time.Time  `json:"created_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+type documentArticle struct {
+	ID         string             `json:"id"`
+	Title      string             `json:"title"`
+	Status     documentStatus     `json:"status"`
+	Category   documentCategory   `json:"category"`
+	Tags       []string           `json:"tags,omitempty"`
+	Author     documentAuthor     `json:"author"`
+	Revisions  []time.Time        `json:"revisions"`
+	Attributes map[string]float64 `json:"attributes"`
+	Subtitle   *string            `json:"subtitle"`
+	Internal   string             `json:"-"`
+	NoJSONTag  int
+	DocumentTimestamps
+}
+
+type customDocument struct {
+	Name string
+}
+
+func (d customDocument) MarshalDocument() (map[string]interface{}, error) {
+	return map[string]interface{}{"custom_name": d.Name}, nil
+}
+
+func TestMarshalDocument(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	joinedAt := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	article := &documentArticle{
+		ID:         "123",
+		Title:      "Typesense",
+		Status:     documentStatusPublished,
+		Category:   "search",
+		Author:     documentAuthor{Name: "Jane", JoinedAt: joinedAt},
+		Revisions:  []time.Time{joinedAt, createdAt},
+		Attributes: map[string]float64{"score": 4.5},
+		Internal:   "hidden",
+		NoJSONTag:  7,
+		DocumentTimestamps: DocumentTimestamps{
+			CreatedAt: createdAt,
+		},
+	}
+
+	document, err := MarshalDocument(article)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"id":       "123",
+		"title":    "Typesense",
+		"status":   documentStatusPublished,
+		"category": documentCategory("search"),
+		"author": map[string]interface{}{
+			"name":      "Jane",
+			"joined_at": joinedAt.Unix(),
+		},
+		"revisions":  []interface{}{joinedAt.Unix(), createdAt.Unix()},
+		"attributes": map[string]interface{}{"score": 4.5},
+		"subtitle":   nil,
+		"NoJSONTag":  7,
+		"created_at": createdAt.Unix(),
+	}, document)
+}
+
+func TestMarshalDocumentWithEnumFieldsKeepsTheirJSONEncoding(t *testing.T) {
+	document, err := MarshalDocument(documentArticle{Status: documentStatusDraft, Category: "news"})
+	assert.NoError(t, err)
+
+	body, err := json.Marshal(document)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `"status":"draft"`)
+	assert.Contains(t, string(body), `"category":"news"`)
+}
+
+func TestMarshalDocumentWithDocumentMarshaler(t *testing.T) {
+	document, err := MarshalDocument(customDocument{Name: "custom"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"custom_name": "custom"}, document)
+
+	document, err = MarshalDocument(struct {
+		Nested customDocument `json:"nested"`
+	}{Nested: customDocument{Name: "nested"}})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"nested": map[string]interface{}{"custom_name": "nested"},
+	}, document)
+}
+
+type documentMoney struct {
+	cents int64
+}
+
+func (m documentMoney) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf(`"%d.%02d"`, m.cents/100, m.cents%100)), nil
+}
+
+type documentUUID [4]byte
+
+func (u documentUUID) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(u[:])), nil
+}
+
+type documentPointerText struct {
+	value string
+}
+
+func (p *documentPointerText) MarshalText() ([]byte, error) {
+	return []byte("text:" + p.value), nil
+}
+
+func TestMarshalDocumentWithFieldMarshalersKeepsTheirJSONEncoding(t *testing.T) {
+	document, err := MarshalDocument(&struct {
+		Price   documentMoney       `json:"price"`
+		UUID    documentUUID        `json:"uuid"`
+		Label   documentPointerText `json:"label"`
+		Count   int64               `json:"count,string"`
+		Enabled *bool               `json:"enabled,string"`
+		Status  documentStatus      `json:"status,string"`
+	}{
+		Price:   documentMoney{cents: 1999},
+		UUID:    documentUUID{0xde, 0xad, 0xbe, 0xef},
+		Label:   documentPointerText{value: "sale"},
+		Count:   42,
+		Enabled: new(bool),
+		Status:  documentStatusPublished,
+	})
+	assert.NoError(t, err)
+
+	body, err := json.Marshal(document)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"price": "19.99",
+		"uuid": "deadbeef",
+		"label": "text:sale",
+		"count": "42",
+		"enabled": "false",
+		"status": "published"
+	}`, string(body))
+}
+
+func TestMarshalDocumentWithNonStructReturnsError(t *testing.T) {
+	_, err := MarshalDocument([]string{"a"})
+	assert.EqualError(t, err, "expected a struct, got []string")
+
+	_, err = MarshalDocument(nil)
+	assert.EqualError(t, err, "expected a struct, got <nil>")
+}
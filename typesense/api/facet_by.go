@@ -0,0 +1,96 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SortOrder is the order of a sort_by clause.
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// FacetRange is a labeled range of a numeric range facet. A nil From or To
+// leaves the range open on that side.
+type FacetRange struct {
+	Label string
+	From  *float64
+	To    *float64
+}
+
+// FacetByBuilder builds the facet_by search parameter, e.g.
+//
+//	FacetBy().Field("category").SortAlpha(SortAsc).Range("price", FacetRange{Label: "cheap", To: pointer.Float64(100)}).String()
+//
+// returns "category(sort_by: _alpha:asc),price(cheap:[, 100])".
+type FacetByBuilder struct {
+	facets []facetSpec
+}
+
+type facetSpec struct {
+	field  string
+	sortBy string
+	ranges []FacetRange
+}
+
+// FacetBy returns an empty facet_by builder.
+func FacetBy() *FacetByBuilder {
+	return &FacetByBuilder{}
+}
+
+// Field adds a facet on the field.
+func (b *FacetByBuilder) Field(name string) *FacetByBuilder {
+	b.facets = append(b.facets, facetSpec{field: name})
+	return b
+}
+
+// SortAlpha sorts the values of the last added facet alphabetically.
+func (b *FacetByBuilder) SortAlpha(order SortOrder) *FacetByBuilder {
+	return b.SortBy("_alpha", order)
+}
+
+// SortBy sorts the values of the last added facet by the value of another field
+// of the documents, e.g. SortBy("num_employees", SortDesc).
+func (b *FacetByBuilder) SortBy(field string, order SortOrder) *FacetByBuilder {
+	if len(b.facets) != 0 {
+		b.facets[len(b.facets)-1].sortBy = fmt.Sprintf("%s:%s", field, order)
+	}
+	return b
+}
+
+// Range adds a range facet on the numeric field, counting the documents in each range.
+func (b *FacetByBuilder) Range(field string, ranges ...FacetRange) *FacetByBuilder {
+	b.facets = append(b.facets, facetSpec{field: field, ranges: ranges})
+	return b
+}
+
+// String returns the facet_by search parameter.
+func (b *FacetByBuilder) String() string {
+	facets := make([]string, len(b.facets))
+	for i, facet := range b.facets {
+		var options []string
+		if facet.sortBy != "" {
+			options = append(options, "sort_by: "+facet.sortBy)
+		}
+		for _, r := range facet.ranges {
+			options = append(options, fmt.Sprintf("%s:[%s, %s]", r.Label, formatRangeBound(r.From), formatRangeBound(r.To)))
+		}
+		if len(options) == 0 {
+			facets[i] = facet.field
+			continue
+		}
+		facets[i] = fmt.Sprintf("%s(%s)", facet.field, strings.Join(options, ", "))
+	}
+	return strings.Join(facets, ",")
+}
+
+func formatRangeBound(bound *float64) string {
+	if bound == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*bound, 'f', -1, 64)
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/typesense/typesense-go/v2/typesense/api/pointer"
+)
+
+func TestFacetByFields(t *testing.T) {
+	assert.Equal(t, "category,brand", FacetBy().Field("category").Field("brand").String())
+	assert.Equal(t, "", FacetBy().String())
+}
+
+func TestFacetBySort(t *testing.T) {
+	assert.Equal(t, "category(sort_by: _alpha:asc)", FacetBy().Field("category").SortAlpha(SortAsc).String())
+	assert.Equal(t, "brand,category(sort_by: num_employees:desc)",
+		FacetBy().Field("brand").Field("category").SortBy("num_employees", SortDesc).String())
+}
+
+func TestFacetByRange(t *testing.T) {
+	facetBy := FacetBy().
+		Range("price",
+			FacetRange{Label: "cheap", To: pointer.Float64(100)},
+			FacetRange{Label: "medium", From: pointer.Float64(100), To: pointer.Float64(199.99)},
+			FacetRange{Label: "expensive", From: pointer.Float64(199.99)}).
+		String()
+	assert.Equal(t, "price(cheap:[, 100], medium:[100, 199.99], expensive:[199.99, ])", facetBy)
+}
+
+func TestFacetByCombined(t *testing.T) {
+	facetBy := FacetBy().
+		Field("category").SortAlpha(SortDesc).
+		Range("rating", FacetRange{Label: "good", From: pointer.Float64(4), To: pointer.Float64(5)}).
+		Field("brand").
+		String()
+	assert.Equal(t, "category(sort_by: _alpha:desc),rating(good:[4, 5]),brand", facetBy)
+}
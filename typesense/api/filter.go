@@ -0,0 +1,144 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidFilter is returned by Filterf for a format or value that can not be
+// safely turned into a filter_by expression.
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// filterOperators are the operators a Filterf placeholder must follow, longest first
+var filterOperators = []string{":!=", ":>=", ":<=", ":=", ":>", ":<", ":"}
+
+// Filterf builds a filter_by expression from a trusted format and untrusted values,
+// e.g.
+//
+//	Filterf("category:=%s && price:>%d", userCategory, minPrice)
+//
+// Each placeholder must directly follow a filter operator (:, :=, :!=, :>, :>=, :<,
+// :<=) or be an element of a list, e.g. "brand:[%s, %s]", so values can only be
+// used as operands. Strings are enclosed in backticks,
+// so that operators, brackets and commas they contain are matched literally; strings
+// containing backticks are rejected as they can not be escaped. Slices are formatted
+// as lists, e.g. [`a`,`b`]. The verbs are:
+//
+//	%s  string or []string
+//	%d  integer or slice of integers
+//	%f  float or slice of floats
+//	%t  bool
+//	%v  any of the above
+//	%%  a literal %
+func Filterf(format string, args ...any) (string, error) {
+	var b strings.Builder
+	argIndex := 0
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c != '%' {
+			b.WriteByte(c)
+			continue
+		}
+		if i+1 == len(format) {
+			return "", fmt.Errorf("%w: format ends with %%", ErrInvalidFilter)
+		}
+		i++
+		verb := format[i]
+		if verb == '%' {
+			b.WriteByte('%')
+			continue
+		}
+		if !followsFilterOperator(b.String()) {
+			return "", fmt.Errorf("%w: %%%c at offset %d does not follow a filter operator", ErrInvalidFilter, verb, i-1)
+		}
+		if argIndex == len(args) {
+			return "", fmt.Errorf("%w: missing value for %%%c", ErrInvalidFilter, verb)
+		}
+		value, err := formatFilterValue(verb, reflect.ValueOf(args[argIndex]))
+		if err != nil {
+			return "", fmt.Errorf("%w: value %d for %%%c: %v", ErrInvalidFilter, argIndex+1, verb, err)
+		}
+		argIndex++
+		b.WriteString(value)
+	}
+	if argIndex != len(args) {
+		return "", fmt.Errorf("%w: %d values given for %d placeholders", ErrInvalidFilter, len(args), argIndex)
+	}
+	return b.String(), nil
+}
+
+// followsFilterOperator reports whether a value may follow prefix: after an operator
+// or as an element of an open list, e.g. "brand:[`acme`, "
+func followsFilterOperator(prefix string) bool {
+	prefix = strings.TrimRight(prefix, " ")
+	for _, operator := range filterOperators {
+		if strings.HasSuffix(prefix, operator) {
+			return true
+		}
+	}
+	if strings.HasSuffix(prefix, "[") || strings.HasSuffix(prefix, ",") {
+		return strings.LastIndex(prefix, "[") > strings.LastIndex(prefix, "]")
+	}
+	return false
+}
+
+func formatFilterValue(verb byte, v reflect.Value) (string, error) {
+	if !v.IsValid() {
+		return "", errors.New("nil value")
+	}
+	if v.Kind() == reflect.Slice {
+		values := make([]string, v.Len())
+		for i := range values {
+			value, err := formatFilterScalar(verb, v.Index(i))
+			if err != nil {
+				return "", err
+			}
+			values[i] = value
+		}
+		return "[" + strings.Join(values, ",") + "]", nil
+	}
+	return formatFilterScalar(verb, v)
+}
+
+func formatFilterScalar(verb byte, v reflect.Value) (string, error) {
+	switch v.Kind() {
+	case reflect.String:
+		if verb != 's' && verb != 'v' {
+			break
+		}
+		s := v.String()
+		if strings.Contains(s, "`") {
+			return "", fmt.Errorf("string %q contains a backtick", s)
+		}
+		return "`" + s + "`", nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if verb != 'd' && verb != 'v' {
+			break
+		}
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if verb != 'd' && verb != 'v' {
+			break
+		}
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		if verb != 'f' && verb != 'v' {
+			break
+		}
+		f := v.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return "", fmt.Errorf("non-finite float %v", f)
+		}
+		return strconv.FormatFloat(f, 'f', -1, v.Type().Bits()), nil
+	case reflect.Bool:
+		if verb != 't' && verb != 'v' {
+			break
+		}
+		return strconv.FormatBool(v.Bool()), nil
+	}
+	return "", fmt.Errorf("unsupported %s for %%%c", v.Type(), verb)
+}
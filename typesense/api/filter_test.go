@@ -0,0 +1,87 @@
+package api
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterf(t *testing.T) {
+	tests := []struct {
+		format   string
+		args     []any
+		expected string
+	}{
+		{"category:=%s && price:>%d", []any{"shoes", 100}, "category:=`shoes` && price:>100"},
+		{"rating:>= %f", []any{4.5}, "rating:>= 4.5"},
+		{"in_stock:%t", []any{true}, "in_stock:true"},
+		{"brand:[%s]", []any{"acme"}, "brand:[`acme`]"},
+		{"brand:[%s, %s]", []any{"acme", "globex"}, "brand:[`acme`, `globex`]"},
+		{"brand:!=%s", []any{[]string{"acme", "globex"}}, "brand:!=[`acme`,`globex`]"},
+		{"id:%v || stock:<%v", []any{[]int64{1, 2}, uint8(3)}, "id:[1,2] || stock:<3"},
+		{"discount:<=%f && name:%s", []any{float32(0.1), "100%"}, "discount:<=0.1 && name:`100%`"},
+		{"tags:=%s && score:>10%%", []any{"a"}, "tags:=`a` && score:>10%"},
+		{"num_employees:>100", nil, "num_employees:>100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			filter, err := Filterf(tt.format, tt.args...)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, filter)
+		})
+	}
+}
+
+func TestFilterfQuotesMaliciousStrings(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"shoes && price:>0", "category:=`shoes && price:>0`"},
+		{"shoes || id:*", "category:=`shoes || id:*`"},
+		{`shoes" || "x`, "category:=`shoes\" || \"x`"},
+		{"shoes') || ('x", "category:=`shoes') || ('x`"},
+		{"[a, b]", "category:=`[a, b]`"},
+		{"shoes), (id:*", "category:=`shoes), (id:*`"},
+		{"", "category:=``"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			filter, err := Filterf("category:=%s", tt.value)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, filter)
+		})
+	}
+}
+
+func TestFilterfRejectsInvalidInput(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		args   []any
+	}{
+		{"backtick in string", "category:=%s", []any{"shoes` || id:*"}},
+		{"backtick in list", "category:=%s", []any{[]string{"a", "b`"}}},
+		{"field placeholder", "%s:=shoes", []any{"category"}},
+		{"placeholder after value", "category:=shoes%s", []any{" || id:*"}},
+		{"placeholder after logical operator", "category:=`a` && %s", []any{"id:*"}},
+		{"placeholder after closed list", "brand:[`acme`],%s", []any{"id:*"}},
+		{"string for %d", "price:>%d", []any{"0 || id:*"}},
+		{"float for %d", "price:>%d", []any{1.5}},
+		{"int for %s", "category:=%s", []any{1}},
+		{"unsupported type", "category:=%v", []any{map[string]string{}}},
+		{"nil value", "category:=%v", []any{nil}},
+		{"NaN", "price:>%f", []any{math.NaN()}},
+		{"infinity", "price:>%f", []any{math.Inf(1)}},
+		{"missing value", "category:=%s && price:>%d", []any{"shoes"}},
+		{"extra value", "category:=%s", []any{"shoes", "boots"}},
+		{"trailing percent", "category:=%", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Filterf(tt.format, tt.args...)
+			assert.ErrorIs(t, err, ErrInvalidFilter)
+		})
+	}
+}
@@ -77,6 +77,9 @@ func main() {
 	// Unwrapping delete document parameters
 	log.Println("Unwrapping documents delete parameters")
 	unwrapDeleteDocument(&m)
+	// Unwrapping get document parameters
+	log.Println("Unwrapping document get parameters")
+	unwrapGetDocument(&m)
 	// Remove additionalProperties from SearchResultHit -> document
 	log.Println("Removing additionalProperties from SearchResultHit")
 	searchResultHit(&m)
@@ -146,6 +149,21 @@ func unwrapDeleteDocument(m *yml) {
 	(*m)["paths"].(yml)["/collections/{collectionName}/documents"].(yml)["delete"].(yml)["parameters"] = parameters
 }
 
+func unwrapGetDocument(m *yml) {
+	parameters := (*m)["paths"].(yml)["/collections/{collectionName}/documents/{documentId}"].(yml)["get"].(yml)["parameters"].([]interface{})
+	getParameters := parameters[2].(yml)["schema"].(yml)["properties"].(yml)
+	for _, obj := range sortedSlice(getParameters) {
+		newMap := make(yml)
+		newMap["name"] = obj.Key
+		newMap["in"] = query
+		newMap["schema"] = make(yml)
+		newMap["schema"].(yml)["type"] = obj.Value.(yml)["type"].(string)
+		parameters = append(parameters, newMap)
+	}
+	parameters = append(parameters[:2], parameters[3:]...)
+	(*m)["paths"].(yml)["/collections/{collectionName}/documents/{documentId}"].(yml)["get"].(yml)["parameters"] = parameters
+}
+
 func unwrapUpdateDocumentsWithConditionParameters(m *yml) {
 	parameters := (*m)["paths"].(yml)["/collections/{collectionName}/documents"].(yml)["patch"].(yml)["parameters"].([]interface{})
 	updateParameters := parameters[1].(yml)["schema"].(yml)["properties"].(yml)
@@ -0,0 +1,10 @@
+package api
+
+import "strings"
+
+// GroupBy returns the group_by search parameter grouping the hits by the values
+// of all the given fields, e.g. GroupBy("brand", "size") returns "brand,size".
+// The group_key of each grouped hit then holds one value per field.
+func GroupBy(fields ...string) string {
+	return strings.Join(fields, ",")
+}
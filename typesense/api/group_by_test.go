@@ -0,0 +1,13 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupBy(t *testing.T) {
+	assert.Equal(t, "brand,size", GroupBy("brand", "size"))
+	assert.Equal(t, "brand", GroupBy("brand"))
+	assert.Equal(t, "", GroupBy())
+}
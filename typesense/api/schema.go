@@ -0,0 +1,179 @@
+package api
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const schemaTag = "typesense"
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// SchemaFromStruct derives a collection schema from the fields of the struct v.
+//
+// Field names are taken from the json tag. Go types are mapped to Typesense types:
+// string to string, int, int64, uint32 and uint64 to int64, smaller integers to int32,
+// floats to float, bool to bool, time.Time to int64 (unix timestamp), structs and maps
+// to object, []byte to string and other slices and arrays to the corresponding []
+// type. Pointer fields are optional. Field attributes are read from the typesense tag, e.g.
+//
+//	Country string `json:"country" typesense:"facet,sort"`
+//
+// Supported attributes are facet, optional, index, sort and infix, which can also be
+// given as e.g. index=false, and type=<typesense type> to override the mapped type.
+// Fields tagged with typesense:"-" or json:"-" and the id field are skipped.
+func SchemaFromStruct(v any, name string) (*CollectionSchema, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a struct, got %T", v)
+	}
+
+	schema := &CollectionSchema{Name: name, Fields: []Field{}}
+	if err := appendStructFields(schema, t); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+func appendStructFields(schema *CollectionSchema, t reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+		jsonName, _, _ := strings.Cut(structField.Tag.Get("json"), ",")
+		typesenseTag := structField.Tag.Get(schemaTag)
+		if jsonName == "-" || typesenseTag == "-" {
+			continue
+		}
+
+		fieldType := structField.Type
+		optional := false
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+			optional = true
+		}
+
+		// promote the fields of embedded structs like encoding/json does
+		if structField.Anonymous && jsonName == "" && fieldType.Kind() == reflect.Struct {
+			if err := appendStructFields(schema, fieldType); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if jsonName == "" {
+			jsonName = structField.Name
+		}
+		if jsonName == "id" {
+			continue
+		}
+
+		field := Field{Name: jsonName}
+		if optional {
+			field.Optional = &optional
+		}
+		if err := applySchemaTag(&field, typesenseTag); err != nil {
+			return fmt.Errorf("field %s: %w", structField.Name, err)
+		}
+		if field.Type == "" {
+			typesenseType, err := typesenseTypeOf(fieldType)
+			if err != nil {
+				return fmt.Errorf("field %s: %w", structField.Name, err)
+			}
+			field.Type = typesenseType
+		}
+		if strings.HasPrefix(field.Type, "object") {
+			enableNestedFields := true
+			schema.EnableNestedFields = &enableNestedFields
+		}
+		schema.Fields = append(schema.Fields, field)
+	}
+	return nil
+}
+
+func applySchemaTag(field *Field, tag string) error {
+	if tag == "" {
+		return nil
+	}
+	for _, option := range strings.Split(tag, ",") {
+		key, value, hasValue := strings.Cut(strings.TrimSpace(option), "=")
+		if key == "type" {
+			field.Type = value
+			continue
+		}
+		enabled := true
+		if hasValue {
+			switch value {
+			case "true":
+			case "false":
+				enabled = false
+			default:
+				return fmt.Errorf("invalid value %q for tag option %s", value, key)
+			}
+		}
+		switch key {
+		case "facet":
+			field.Facet = &enabled
+		case "optional":
+			field.Optional = &enabled
+		case "index":
+			field.Index = &enabled
+		case "sort":
+			field.Sort = &enabled
+		case "infix":
+			field.Infix = &enabled
+		default:
+			return fmt.Errorf("unknown tag option %q", key)
+		}
+	}
+	return nil
+}
+
+func typesenseTypeOf(t reflect.Type) (string, error) {
+	if t == timeType {
+		return "int64", nil
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string", nil
+	case reflect.Bool:
+		return "bool", nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return "int32", nil
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return "int64", nil
+	case reflect.Float32, reflect.Float64:
+		return "float", nil
+	case reflect.Struct, reflect.Map:
+		return "object", nil
+	case reflect.Interface:
+		return "auto", nil
+	case reflect.Slice, reflect.Array:
+		// encoding/json encodes byte slices as base64 strings
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return "string", nil
+		}
+		elem := t.Elem()
+		for elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+		elemType, err := typesenseTypeOf(elem)
+		if err != nil {
+			return "", err
+		}
+		if strings.HasSuffix(elemType, "[]") || elemType == "auto" {
+			return "", fmt.Errorf("unsupported type %s", t)
+		}
+		return elemType + "[]", nil
+	case reflect.Ptr:
+		return typesenseTypeOf(t.Elem())
+	default:
+		return "", fmt.Errorf("unsupported type %s", t)
+	}
+}
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type schemaAuthor struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type SchemaTimestamps struct {
+	CreatedAt time.Time  `json:"created_at" typesense:"sort"`
+	DeletedAt *time.Time `json:"deleted_at"`
+}
+
+type schemaBook struct {
+	ID         string                 `json:"id"`
+	Title      string                 `json:"title" typesense:"infix"`
+	Pages      int32                  `json:"pages" typesense:"sort"`
+	Copies     int                    `json:"copies"`
+	Rating     float64                `json:"rating" typesense:"sort,facet=false"`
+	InStock    bool                   `json:"in_stock" typesense:"facet"`
+	Genres     []string               `json:"genres" typesense:"facet"`
+	Scores     []float32              `json:"scores" typesense:"index=false,optional"`
+	Subtitle   *string                `json:"subtitle"`
+	Author     schemaAuthor           `json:"author"`
+	Reviewers  []*schemaAuthor        `json:"reviewers"`
+	Attributes map[string]interface{} `json:"attributes"`
+	Location   []float64              `json:"location" typesense:"type=geopoint"`
+	Checksum   []byte                 `json:"checksum"`
+	Internal   string                 `json:"-"`
+	Ignored    string                 `json:"ignored" typesense:"-"`
+	NoJSONTag  string
+	unexported string
+	SchemaTimestamps
+}
+
+func TestSchemaFromStruct(t *testing.T) {
+	enabled, disabled := true, false
+	expected := &CollectionSchema{
+		Name: "books",
+		Fields: []Field{
+			{Name: "title", Type: "string", Infix: &enabled},
+			{Name: "pages", Type: "int32", Sort: &enabled},
+			{Name: "copies", Type: "int64"},
+			{Name: "rating", Type: "float", Sort: &enabled, Facet: &disabled},
+			{Name: "in_stock", Type: "bool", Facet: &enabled},
+			{Name: "genres", Type: "string[]", Facet: &enabled},
+			{Name: "scores", Type: "float[]", Index: &disabled, Optional: &enabled},
+			{Name: "subtitle", Type: "string", Optional: &enabled},
+			{Name: "author", Type: "object"},
+			{Name: "reviewers", Type: "object[]"},
+			{Name: "attributes", Type: "object"},
+			{Name: "location", Type: "geopoint"},
+			{Name: "checksum", Type: "string"},
+			{Name: "NoJSONTag", Type: "string"},
+			{Name: "created_at", Type: "int64", Sort: &enabled},
+			{Name: "deleted_at", Type: "int64", Optional: &enabled},
+		},
+		EnableNestedFields: &enabled,
+	}
+
+	schema, err := SchemaFromStruct(schemaBook{}, "books")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, schema)
+
+	schema, err = SchemaFromStruct(&schemaBook{}, "books")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, schema)
+}
+
+func TestSchemaFromStructWithFlatStructDoesNotEnableNestedFields(t *testing.T) {
+	schema, err := SchemaFromStruct(schemaAuthor{}, "authors")
+	assert.NoError(t, err)
+	assert.Equal(t, &CollectionSchema{
+		Name: "authors",
+		Fields: []Field{
+			{Name: "name", Type: "string"},
+			{Name: "email", Type: "string"},
+		},
+	}, schema)
+}
+
+func TestSchemaFromStructWithSortableStringFieldRoundTrip(t *testing.T) {
+	type company struct {
+		Name    string `json:"company_name" typesense:"sort"`
+		Country string `json:"country" typesense:"sort=false"`
+	}
+	enabled, disabled := true, false
+
+	schema, err := SchemaFromStruct(company{}, "companies")
+	assert.NoError(t, err)
+	assert.Equal(t, []Field{
+		{Name: "company_name", Type: "string", Sort: &enabled},
+		{Name: "country", Type: "string", Sort: &disabled},
+	}, schema.Fields)
+
+	data, err := json.Marshal(schema)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"name": "companies",
+		"fields": [
+			{"name": "company_name", "type": "string", "sort": true},
+			{"name": "country", "type": "string", "sort": false}
+		]
+	}`, string(data))
+
+	var decoded CollectionSchema
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, schema, &decoded)
+}
+
+func TestSchemaFromStructErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		expectedErr string
+	}{
+		{
+			name:        "not a struct",
+			value:       "books",
+			expectedErr: "expected a struct, got string",
+		},
+		{
+			name:        "nil",
+			value:       nil,
+			expectedErr: "expected a struct, got <nil>",
+		},
+		{
+			name: "unsupported type",
+			value: struct {
+				Callback func() `json:"callback"`
+			}{},
+			expectedErr: "field Callback: unsupported type func()",
+		},
+		{
+			name: "nested slices",
+			value: struct {
+				Matrix [][]int `json:"matrix"`
+			}{},
+			expectedErr: "field Matrix: unsupported type [][]int",
+		},
+		{
+			name: "unknown tag option",
+			value: struct {
+				Title string `json:"title" typesense:"facet,stored"`
+			}{},
+			expectedErr: `field Title: unknown tag option "stored"`,
+		},
+		{
+			name: "invalid tag value",
+			value: struct {
+				Title string `json:"title" typesense:"facet=yes"`
+			}{},
+			expectedErr: `field Title: invalid value "yes" for tag option facet`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SchemaFromStruct(tt.value, "books")
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
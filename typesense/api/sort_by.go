@@ -0,0 +1,62 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MissingValues is the position of the documents without a value for the sort field.
+type MissingValues string
+
+const (
+	MissingValuesFirst MissingValues = "first"
+	MissingValuesLast  MissingValues = "last"
+)
+
+// SortByBuilder builds the sort_by search parameter, e.g.
+//
+//	SortBy().Field("rating", SortDesc).MissingValues(MissingValuesLast).Field("_text_match", SortDesc).String()
+//
+// returns "rating(missing_values: last):desc,_text_match:desc".
+type SortByBuilder struct {
+	fields []sortSpec
+}
+
+type sortSpec struct {
+	field         string
+	order         SortOrder
+	missingValues MissingValues
+}
+
+// SortBy returns an empty sort_by builder.
+func SortBy() *SortByBuilder {
+	return &SortByBuilder{}
+}
+
+// Field adds a sort on the field in the given order.
+func (b *SortByBuilder) Field(name string, order SortOrder) *SortByBuilder {
+	b.fields = append(b.fields, sortSpec{field: name, order: order})
+	return b
+}
+
+// MissingValues places the documents without a value for the last added sort
+// field first or last, whatever the sort order.
+func (b *SortByBuilder) MissingValues(position MissingValues) *SortByBuilder {
+	if len(b.fields) != 0 {
+		b.fields[len(b.fields)-1].missingValues = position
+	}
+	return b
+}
+
+// String returns the sort_by search parameter.
+func (b *SortByBuilder) String() string {
+	fields := make([]string, len(b.fields))
+	for i, field := range b.fields {
+		if field.missingValues == "" {
+			fields[i] = fmt.Sprintf("%s:%s", field.field, field.order)
+			continue
+		}
+		fields[i] = fmt.Sprintf("%s(missing_values: %s):%s", field.field, field.missingValues, field.order)
+	}
+	return strings.Join(fields, ",")
+}
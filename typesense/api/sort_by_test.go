@@ -0,0 +1,23 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortByFields(t *testing.T) {
+	assert.Equal(t, "num_employees:desc,company_name:asc",
+		SortBy().Field("num_employees", SortDesc).Field("company_name", SortAsc).String())
+	assert.Equal(t, "", SortBy().String())
+}
+
+func TestSortByMissingValues(t *testing.T) {
+	sortBy := SortBy().
+		Field("rating", SortDesc).MissingValues(MissingValuesLast).
+		Field("_text_match", SortDesc).
+		Field("released_at", SortAsc).MissingValues(MissingValuesFirst).
+		String()
+	assert.Equal(t, "rating(missing_values: last):desc,_text_match:desc,released_at(missing_values: first):asc", sortBy)
+	assert.Equal(t, "", SortBy().MissingValues(MissingValuesFirst).String())
+}
@@ -1,7 +1,148 @@
 package api
 
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 type ImportDocumentResponse struct {
 	Success  bool   `json:"success"`
 	Error    string `json:"error"`
 	Document string `json:"document"`
 }
+
+// ImportResult is the result of importing a single document streamed from a channel.
+type ImportResult struct {
+	// Response is the import result of the document, nil when Err is set
+	Response *ImportDocumentResponse
+	// Err is the error of the import request of the batch the document belongs to
+	Err error
+}
+
+// DeleteDocumentsResult is the result of deleting documents by filter.
+type DeleteDocumentsResult struct {
+	NumDeleted int `json:"num_deleted"`
+}
+
+// Validate checks that the default_sorting_field of the schema, if set, refers to
+// an existing numeric field that has sorting enabled.
+func (s *CollectionSchema) Validate() error {
+	if s.DefaultSortingField == nil || *s.DefaultSortingField == "" {
+		return nil
+	}
+	name := *s.DefaultSortingField
+	for _, field := range s.Fields {
+		if field.Name != name {
+			continue
+		}
+		switch field.Type {
+		case "int32", "int64", "float":
+		default:
+			return fmt.Errorf("default_sorting_field %q must be of type int32, int64 or float, got %q", name, field.Type)
+		}
+		if field.Sort != nil && !*field.Sort {
+			return fmt.Errorf("default_sorting_field %q must have sorting enabled", name)
+		}
+		return nil
+	}
+	return fmt.Errorf("default_sorting_field %q is not a field of the schema", name)
+}
+
+// HighlightField returns the highlight of the field at the given path from the
+// highlight map of the hit. Nested object fields are addressed with dotted
+// paths, e.g. "author.name". The returned SearchHighlight has Field set to path.
+func (h *SearchResultHit) HighlightField(path string) (*SearchHighlight, bool) {
+	if h.Highlight == nil {
+		return nil, false
+	}
+	var value interface{} = *h.Highlight
+	for _, name := range strings.Split(path, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = m[name]; !ok {
+			return nil, false
+		}
+	}
+	// a highlighted leaf always carries the matched tokens, whereas
+	// intermediate objects only contain the highlights of their children
+	leaf, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	if _, ok := leaf["matched_tokens"]; !ok {
+		return nil, false
+	}
+	data, err := json.Marshal(leaf)
+	if err != nil {
+		return nil, false
+	}
+	highlight := &SearchHighlight{}
+	if err := json.Unmarshal(data, highlight); err != nil {
+		return nil, false
+	}
+	highlight.Field = &path
+	return highlight, true
+}
+
+// TotalSearchTimeMs returns the sum of the search times of the results, as the
+// multi search response has no aggregate search time. Results without a search
+// time, e.g. failed searches, are skipped.
+func (r *MultiSearchResult) TotalSearchTimeMs() int {
+	total := 0
+	for _, result := range r.Results {
+		if result.SearchTimeMs != nil {
+			total += *result.SearchTimeMs
+		}
+	}
+	return total
+}
+
+// PrefixPerField builds the prefix search parameter for multiple query_by
+// fields, e.g. PrefixPerField([]bool{true, false}) returns "true,false".
+// The number of values must match the number of query_by fields.
+func PrefixPerField(prefixes []bool) string {
+	values := make([]string, len(prefixes))
+	for i, prefix := range prefixes {
+		values[i] = strconv.FormatBool(prefix)
+	}
+	return strings.Join(values, ",")
+}
+
+// ValidatePrefix checks that the prefix search parameter holds either a single
+// value or one value per query_by field.
+func ValidatePrefix(prefix string, queryBy string) error {
+	return validatePerFieldParam("prefix", prefix, queryBy)
+}
+
+// NumTyposPerField builds the num_typos search parameter for multiple query_by
+// fields, e.g. NumTyposPerField([]int{2, 0}) returns "2,0".
+// The number of values must match the number of query_by fields.
+func NumTyposPerField(numTypos []int) string {
+	values := make([]string, len(numTypos))
+	for i, n := range numTypos {
+		values[i] = strconv.Itoa(n)
+	}
+	return strings.Join(values, ",")
+}
+
+// ValidateNumTypos checks that the num_typos search parameter holds either a
+// single value or one value per query_by field.
+func ValidateNumTypos(numTypos string, queryBy string) error {
+	return validatePerFieldParam("num_typos", numTypos, queryBy)
+}
+
+func validatePerFieldParam(name string, value string, queryBy string) error {
+	valueCount := len(strings.Split(value, ","))
+	if valueCount == 1 {
+		return nil
+	}
+	queryByCount := len(strings.Split(queryBy, ","))
+	if valueCount != queryByCount {
+		return fmt.Errorf("invalid search parameter %s: %d values given for %d query_by fields", name, valueCount, queryByCount)
+	}
+	return nil
+}
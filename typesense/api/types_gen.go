@@ -21,7 +21,8 @@ const (
 
 // Defines values for IndexDocumentParamsAction.
 const (
-	Upsert IndexDocumentParamsAction = "upsert"
+	Emplace IndexDocumentParamsAction = "emplace"
+	Upsert  IndexDocumentParamsAction = "upsert"
 )
 
 // Defines values for ImportDocumentsParamsDirtyValues.
@@ -136,6 +137,9 @@ type CollectionResponse struct {
 	// Fields A list of fields for querying, filtering and faceting
 	Fields []Field `json:"fields"`
 
+	// Metadata Optional details about the collection, e.g., when it was created, who created it etc.
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+
 	// Name Name of the collection
 	Name string `json:"name"`
 
@@ -145,8 +149,14 @@ type CollectionResponse struct {
 	// SymbolsToIndex List of symbols or special characters to be indexed.
 	SymbolsToIndex *[]string `json:"symbols_to_index,omitempty"`
 
+	// SynonymSets List of synonym set names to associate with this collection
+	SynonymSets *[]string `json:"synonym_sets,omitempty"`
+
 	// TokenSeparators List of symbols or special characters to be used for splitting the text into individual words in addition to space and new-line characters.
 	TokenSeparators *[]string `json:"token_separators,omitempty"`
+
+	// VoiceQueryModel Configuration for the voice query model
+	VoiceQueryModel *VoiceQueryModelCollectionConfig `json:"voice_query_model,omitempty"`
 }
 
 // CollectionSchema defines model for CollectionSchema.
@@ -160,15 +170,24 @@ type CollectionSchema struct {
 	// Fields A list of fields for querying, filtering and faceting
 	Fields []Field `json:"fields"`
 
+	// Metadata Optional details about the collection, e.g., when it was created, who created it etc.
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+
 	// Name Name of the collection
 	Name string `json:"name"`
 
 	// SymbolsToIndex List of symbols or special characters to be indexed.
 	SymbolsToIndex *[]string `json:"symbols_to_index,omitempty"`
 
+	// SynonymSets List of synonym set names to associate with this collection
+	SynonymSets *[]string `json:"synonym_sets,omitempty"`
+
 	// TokenSeparators List of symbols or special characters to be used for splitting the text into individual words in addition to space and new-line characters.
 	TokenSeparators *[]string `json:"token_separators,omitempty"`
 
+	// VoiceQueryModel Configuration for the voice query model
+	VoiceQueryModel *VoiceQueryModelCollectionConfig `json:"voice_query_model,omitempty"`
+
 	// The dirty_values parameter determines what Typesense should do when the type of a particular field being indexed does not match the previously inferred type for that field, or the one defined in the collection's schema.
 	DirtyValues *string `json:"dirty_values,omitempty"`
 }
@@ -177,6 +196,9 @@ type CollectionSchema struct {
 type CollectionUpdateSchema struct {
 	// Fields A list of fields for querying, filtering and faceting
 	Fields []Field `json:"fields"`
+
+	// Metadata Optional details about the collection, e.g., when it was created, who created it etc. Metadata is the only collection level setting that can be altered.
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
 // FacetCounts defines model for FacetCounts.
@@ -199,28 +221,66 @@ type FacetCounts struct {
 
 // Field defines model for Field.
 type Field struct {
-	Drop  *bool `json:"drop,omitempty"`
-	Embed *struct {
+	// AsyncReference Allow documents to be indexed before the referenced document exists. Only applies to fields with a reference.
+	AsyncReference *bool `json:"async_reference,omitempty"`
+	Drop           *bool `json:"drop,omitempty"`
+	Embed          *struct {
 		From        []string `json:"from"`
 		ModelConfig struct {
-			AccessToken  *string `json:"access_token,omitempty"`
-			ApiKey       *string `json:"api_key,omitempty"`
-			ClientId     *string `json:"client_id,omitempty"`
-			ClientSecret *string `json:"client_secret,omitempty"`
-			ModelName    string  `json:"model_name"`
-			ProjectId    *string `json:"project_id,omitempty"`
+			AccessToken    *string `json:"access_token,omitempty"`
+			ApiKey         *string `json:"api_key,omitempty"`
+			ClientId       *string `json:"client_id,omitempty"`
+			ClientSecret   *string `json:"client_secret,omitempty"`
+			IndexingPrefix *string `json:"indexing_prefix,omitempty"`
+			ModelName      string  `json:"model_name"`
+			ProjectId      *string `json:"project_id,omitempty"`
+			QueryPrefix    *string `json:"query_prefix,omitempty"`
+			Url            *string `json:"url,omitempty"`
 		} `json:"model_config"`
 	} `json:"embed,omitempty"`
-	Facet     *bool   `json:"facet,omitempty"`
-	Index     *bool   `json:"index,omitempty"`
-	Infix     *bool   `json:"infix,omitempty"`
-	Locale    *string `json:"locale,omitempty"`
-	Name      string  `json:"name"`
-	NumDim    *int    `json:"num_dim,omitempty"`
-	Optional  *bool   `json:"optional,omitempty"`
-	Reference *string `json:"reference,omitempty"`
-	Sort      *bool   `json:"sort,omitempty"`
-	Type      string  `json:"type"`
+	Facet *bool `json:"facet,omitempty"`
+
+	// HnswParams Parameters of the HNSW index built for a vector field.
+	HnswParams *FieldHnswParams `json:"hnsw_params,omitempty"`
+	Index      *bool            `json:"index,omitempty"`
+	Infix      *bool            `json:"infix,omitempty"`
+	Locale     *string          `json:"locale,omitempty"`
+	Name       string           `json:"name"`
+	NumDim     *int             `json:"num_dim,omitempty"`
+	Optional   *bool            `json:"optional,omitempty"`
+
+	// RangeIndex Enables an index optimized for range filtering on numerical fields (e.g. rating:>3.5). Default: false.
+	RangeIndex *bool   `json:"range_index,omitempty"`
+	Reference  *string `json:"reference,omitempty"`
+	Sort       *bool   `json:"sort,omitempty"`
+
+	// Stem Values are stemmed before indexing in-memory. Default: false.
+	Stem *bool `json:"stem,omitempty"`
+
+	// StemDictionary Name of the stemming dictionary to use for this field
+	StemDictionary *string `json:"stem_dictionary,omitempty"`
+
+	// Store When set to false, the field value will not be stored on disk. Default: true.
+	Store *bool `json:"store,omitempty"`
+
+	// SymbolsToIndex List of symbols or special characters to be indexed.
+	SymbolsToIndex *[]string `json:"symbols_to_index,omitempty"`
+
+	// TokenSeparators List of symbols or special characters to be used for splitting the text into individual words in addition to space and new-line characters.
+	TokenSeparators *[]string `json:"token_separators,omitempty"`
+	Type            string    `json:"type"`
+
+	// VecDist The distance metric to be used for vector search. Default: `cosine`. You can also use `ip` for inner product.
+	VecDist *string `json:"vec_dist,omitempty"`
+}
+
+// FieldHnswParams Parameters of the HNSW index built for a vector field.
+type FieldHnswParams struct {
+	// M The number of bi-directional links created for every new element during construction. Default: 16
+	M *int `json:"M,omitempty"`
+
+	// EfConstruction The size of the dynamic list for the nearest neighbors used during construction. Default: 200
+	EfConstruction *int `json:"ef_construction,omitempty"`
 }
 
 // HealthStatus defines model for HealthStatus.
@@ -236,12 +296,36 @@ type MultiSearchCollectionParameters struct {
 	// Collection The collection to search in.
 	Collection string `json:"collection"`
 
+	// Conversation Enable conversational search.
+	Conversation *bool `json:"conversation,omitempty"`
+
+	// ConversationId The Id of a previous conversation to continue, this tells Typesense to include prior context when communicating with the LLM.
+	ConversationId *string `json:"conversation_id,omitempty"`
+
+	// ConversationModelId The Id of Conversation Model to be used.
+	ConversationModelId *string `json:"conversation_model_id,omitempty"`
+
+	// ConversationStream Stream the conversational search answer as server-sent events.
+	ConversationStream *bool `json:"conversation_stream,omitempty"`
+
+	// DropTokensMode Dictates the direction in which the words in the query must be dropped when the original words in the query do not appear in any document. Values: right_to_left (default), left_to_right, both_sides:3. A note on both_sides:3 - for queries up to 3 tokens (words) in length, this mode will drop tokens from both sides and exhaustively rank all matching results. If query length is greater than 3 words, Typesense will just fallback to default behavior of right_to_left
+	DropTokensMode *string `json:"drop_tokens_mode,omitempty"`
+
 	// DropTokensThreshold If the number of results found for a specific query is less than this number, Typesense will attempt to drop the tokens in the query until enough results are found. Tokens that have the least individual hits are dropped first. Set to 0 to disable. Default: 10
 	DropTokensThreshold *int `json:"drop_tokens_threshold,omitempty"`
 
+	// EnableLazyFilter Applies the filters lazily, evaluating them only against the documents that match the query tokens. Useful for expensive filters on large collections. Default: false.
+	EnableLazyFilter *bool `json:"enable_lazy_filter,omitempty"`
+
 	// EnableOverrides If you have some overrides defined but want to disable all of them during query time, you can do that by setting this parameter to false
 	EnableOverrides *bool `json:"enable_overrides,omitempty"`
 
+	// EnableSynonyms If you have some synonyms defined but want to disable all of them for a particular search query, set enable_synonyms to false. Default: true
+	EnableSynonyms *bool `json:"enable_synonyms,omitempty"`
+
+	// EnableTyposForAlphaNumericalTokens Set this parameter to false to disable typos on alphanumerical query tokens. Default: true.
+	EnableTyposForAlphaNumericalTokens *bool `json:"enable_typos_for_alpha_numerical_tokens,omitempty"`
+
 	// EnableTyposForNumericalTokens Make Typesense disable typos for numerical tokens.
 	EnableTyposForNumericalTokens *bool `json:"enable_typos_for_numerical_tokens,omitempty"`
 
@@ -266,6 +350,9 @@ type MultiSearchCollectionParameters struct {
 	// FilterBy Filter conditions for refining youropen api validator search results. Separate multiple conditions with &&.
 	FilterBy *string `json:"filter_by,omitempty"`
 
+	// FilterCuratedHits Whether the filter_by condition of the search query should be applicable to curated results (override definitions, pinned hits, hidden hits, etc.). Default: false
+	FilterCuratedHits *bool `json:"filter_curated_hits,omitempty"`
+
 	// GroupBy You can aggregate search results into groups or buckets by specify one or more `group_by` fields. Separate multiple fields with a comma. To group on a particular field, it must be a faceted field.
 	GroupBy *string `json:"group_by,omitempty"`
 
@@ -309,12 +396,21 @@ type MultiSearchCollectionParameters struct {
 	// MaxFacetValues Maximum number of facet values to be returned.
 	MaxFacetValues *int `json:"max_facet_values,omitempty"`
 
+	// MaxFilterByCandidates Controls the number of similar words that Typesense considers during fuzzy search on filter_by values. Useful for controlling prefix matches like company_name:Acm*.
+	MaxFilterByCandidates *int `json:"max_filter_by_candidates,omitempty"`
+
 	// MinLen1typo Minimum word length for 1-typo correction to be applied. The value of num_typos is still treated as the maximum allowed typos.
 	MinLen1typo *int `json:"min_len_1typo,omitempty"`
 
 	// MinLen2typo Minimum word length for 2-typo correction to be applied. The value of num_typos is still treated as the maximum allowed typos.
 	MinLen2typo *int `json:"min_len_2typo,omitempty"`
 
+	// NlModelId The ID of the natural language model to use for parsing the query.
+	NlModelId *string `json:"nl_model_id,omitempty"`
+
+	// NlQuery Set this parameter to true to parse the query with a natural language model into search parameters such as filter_by and sort_by.
+	NlQuery *bool `json:"nl_query,omitempty"`
+
 	// NumTypos The number of typographical errors (1 or 2) that would be tolerated. Default: 2
 	NumTypos *string `json:"num_typos,omitempty"`
 
@@ -368,6 +464,9 @@ type MultiSearchCollectionParameters struct {
 	// RemoteEmbeddingTimeoutMs Timeout (in milliseconds) for fetching remote embeddings.
 	RemoteEmbeddingTimeoutMs *int `json:"remote_embedding_timeout_ms,omitempty"`
 
+	// RerankHybridMatches When true, computes both text match and vector distance scores for all matches in hybrid search. Documents found only through keyword search will get a vector distance score, and documents found only through vector search will get a text match score. Default: false
+	RerankHybridMatches *bool `json:"rerank_hybrid_matches,omitempty"`
+
 	// SearchCutoffMs Typesense will attempt to return results early if the cutoff time has elapsed. This is not a strict guarantee and facet computation is not bound by this parameter.
 	SearchCutoffMs *int `json:"search_cutoff_ms,omitempty"`
 
@@ -380,6 +479,15 @@ type MultiSearchCollectionParameters struct {
 	// Stopwords Name of the stopwords set to apply for this search, the keywords present in the set will be removed from the search query.
 	Stopwords *string `json:"stopwords,omitempty"`
 
+	// SynonymNumTypos Allow synonym resolution on typo-corrected words in the query. Default: 0
+	SynonymNumTypos *int `json:"synonym_num_typos,omitempty"`
+
+	// SynonymPrefix Allow synonym resolution on word prefixes in the query. Default: false
+	SynonymPrefix *bool `json:"synonym_prefix,omitempty"`
+
+	// SynonymSets Comma separated list of synonym set names to apply to the search query.
+	SynonymSets *string `json:"synonym_sets,omitempty"`
+
 	// TextMatchType In a multi-field matching context, this parameter determines how the representative text match score of a record is calculated. Possible values are max_score (default) or max_weight.
 	TextMatchType *string `json:"text_match_type,omitempty"`
 
@@ -392,6 +500,9 @@ type MultiSearchCollectionParameters struct {
 	// VectorQuery Vector query expression for fetching documents "closest" to a given query/document vector.
 	VectorQuery *string `json:"vector_query,omitempty"`
 
+	// VoiceQuery The base64 encoded audio file in 16 khz 16-bit WAV format.
+	VoiceQuery *string `json:"voice_query,omitempty"`
+
 	// MaxCandidates Control the number of similar words that Typesense considers for prefix and typo searching .
 	MaxCandidates *int `json:"max_candidates,omitempty"`
 }
@@ -401,12 +512,36 @@ type MultiSearchParameters struct {
 	// CacheTtl The duration (in seconds) that determines how long the search query is cached. This value can be set on a per-query basis. Default: 60.
 	CacheTtl *int `json:"cache_ttl,omitempty"`
 
+	// Conversation Enable conversational search.
+	Conversation *bool `json:"conversation,omitempty"`
+
+	// ConversationId The Id of a previous conversation to continue, this tells Typesense to include prior context when communicating with the LLM.
+	ConversationId *string `json:"conversation_id,omitempty"`
+
+	// ConversationModelId The Id of Conversation Model to be used.
+	ConversationModelId *string `json:"conversation_model_id,omitempty"`
+
+	// ConversationStream Stream the conversational search answer as server-sent events.
+	ConversationStream *bool `json:"conversation_stream,omitempty"`
+
+	// DropTokensMode Dictates the direction in which the words in the query must be dropped when the original words in the query do not appear in any document. Values: right_to_left (default), left_to_right, both_sides:3. A note on both_sides:3 - for queries up to 3 tokens (words) in length, this mode will drop tokens from both sides and exhaustively rank all matching results. If query length is greater than 3 words, Typesense will just fallback to default behavior of right_to_left
+	DropTokensMode *string `json:"drop_tokens_mode,omitempty"`
+
 	// DropTokensThreshold If the number of results found for a specific query is less than this number, Typesense will attempt to drop the tokens in the query until enough results are found. Tokens that have the least individual hits are dropped first. Set to 0 to disable. Default: 10
 	DropTokensThreshold *int `json:"drop_tokens_threshold,omitempty"`
 
+	// EnableLazyFilter Applies the filters lazily, evaluating them only against the documents that match the query tokens. Useful for expensive filters on large collections. Default: false.
+	EnableLazyFilter *bool `json:"enable_lazy_filter,omitempty"`
+
 	// EnableOverrides If you have some overrides defined but want to disable all of them during query time, you can do that by setting this parameter to false
 	EnableOverrides *bool `json:"enable_overrides,omitempty"`
 
+	// EnableSynonyms If you have some synonyms defined but want to disable all of them for a particular search query, set enable_synonyms to false. Default: true
+	EnableSynonyms *bool `json:"enable_synonyms,omitempty"`
+
+	// EnableTyposForAlphaNumericalTokens Set this parameter to false to disable typos on alphanumerical query tokens. Default: true.
+	EnableTyposForAlphaNumericalTokens *bool `json:"enable_typos_for_alpha_numerical_tokens,omitempty"`
+
 	// EnableTyposForNumericalTokens Make Typesense disable typos for numerical tokens.
 	EnableTyposForNumericalTokens *bool `json:"enable_typos_for_numerical_tokens,omitempty"`
 
@@ -431,6 +566,9 @@ type MultiSearchParameters struct {
 	// FilterBy Filter conditions for refining youropen api validator search results. Separate multiple conditions with &&.
 	FilterBy *string `json:"filter_by,omitempty"`
 
+	// FilterCuratedHits Whether the filter_by condition of the search query should be applicable to curated results (override definitions, pinned hits, hidden hits, etc.). Default: false
+	FilterCuratedHits *bool `json:"filter_curated_hits,omitempty"`
+
 	// GroupBy You can aggregate search results into groups or buckets by specify one or more `group_by` fields. Separate multiple fields with a comma. To group on a particular field, it must be a faceted field.
 	GroupBy *string `json:"group_by,omitempty"`
 
@@ -474,12 +612,21 @@ type MultiSearchParameters struct {
 	// MaxFacetValues Maximum number of facet values to be returned.
 	MaxFacetValues *int `json:"max_facet_values,omitempty"`
 
+	// MaxFilterByCandidates Controls the number of similar words that Typesense considers during fuzzy search on filter_by values. Useful for controlling prefix matches like company_name:Acm*.
+	MaxFilterByCandidates *int `json:"max_filter_by_candidates,omitempty"`
+
 	// MinLen1typo Minimum word length for 1-typo correction to be applied. The value of num_typos is still treated as the maximum allowed typos.
 	MinLen1typo *int `json:"min_len_1typo,omitempty"`
 
 	// MinLen2typo Minimum word length for 2-typo correction to be applied. The value of num_typos is still treated as the maximum allowed typos.
 	MinLen2typo *int `json:"min_len_2typo,omitempty"`
 
+	// NlModelId The ID of the natural language model to use for parsing the query.
+	NlModelId *string `json:"nl_model_id,omitempty"`
+
+	// NlQuery Set this parameter to true to parse the query with a natural language model into search parameters such as filter_by and sort_by.
+	NlQuery *bool `json:"nl_query,omitempty"`
+
 	// NumTypos The number of typographical errors (1 or 2) that would be tolerated. Default: 2
 	NumTypos *string `json:"num_typos,omitempty"`
 
@@ -533,6 +680,9 @@ type MultiSearchParameters struct {
 	// RemoteEmbeddingTimeoutMs Timeout (in milliseconds) for fetching remote embeddings.
 	RemoteEmbeddingTimeoutMs *int `json:"remote_embedding_timeout_ms,omitempty"`
 
+	// RerankHybridMatches When true, computes both text match and vector distance scores for all matches in hybrid search. Documents found only through keyword search will get a vector distance score, and documents found only through vector search will get a text match score. Default: false
+	RerankHybridMatches *bool `json:"rerank_hybrid_matches,omitempty"`
+
 	// SearchCutoffMs Typesense will attempt to return results early if the cutoff time has elapsed. This is not a strict guarantee and facet computation is not bound by this parameter.
 	SearchCutoffMs *int `json:"search_cutoff_ms,omitempty"`
 
@@ -545,6 +695,15 @@ type MultiSearchParameters struct {
 	// Stopwords Name of the stopwords set to apply for this search, the keywords present in the set will be removed from the search query.
 	Stopwords *string `json:"stopwords,omitempty"`
 
+	// SynonymNumTypos Allow synonym resolution on typo-corrected words in the query. Default: 0
+	SynonymNumTypos *int `json:"synonym_num_typos,omitempty"`
+
+	// SynonymPrefix Allow synonym resolution on word prefixes in the query. Default: false
+	SynonymPrefix *bool `json:"synonym_prefix,omitempty"`
+
+	// SynonymSets Comma separated list of synonym set names to apply to the search query.
+	SynonymSets *string `json:"synonym_sets,omitempty"`
+
 	// TextMatchType In a multi-field matching context, this parameter determines how the representative text match score of a record is calculated. Possible values are max_score (default) or max_weight.
 	TextMatchType *string `json:"text_match_type,omitempty"`
 
@@ -556,11 +715,15 @@ type MultiSearchParameters struct {
 
 	// VectorQuery Vector query expression for fetching documents "closest" to a given query/document vector.
 	VectorQuery *string `json:"vector_query,omitempty"`
+
+	// VoiceQuery The base64 encoded audio file in 16 khz 16-bit WAV format.
+	VoiceQuery *string `json:"voice_query,omitempty"`
 }
 
 // MultiSearchResult defines model for MultiSearchResult.
 type MultiSearchResult struct {
-	Results []SearchResult `json:"results"`
+	Conversation *SearchResultConversation `json:"conversation,omitempty"`
+	Results      []SearchResult            `json:"results"`
 }
 
 // MultiSearchSearchesParameter defines model for MultiSearchSearchesParameter.
@@ -641,6 +804,9 @@ type SearchOverride struct {
 	// Includes List of document `id`s that should be included in the search results with their corresponding `position`s.
 	Includes *[]SearchOverrideInclude `json:"includes,omitempty"`
 
+	// Metadata Return a custom JSON object in the Search API response, when this rule is triggered. This can be used to display a pre-defined message (eg: a promotion banner) on the front-end when a particular rule is triggered.
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+
 	// RemoveMatchedTokens Indicates whether search query tokens that exist in the override's rule should be removed from the search query.
 	RemoveMatchedTokens *bool              `json:"remove_matched_tokens,omitempty"`
 	Rule                SearchOverrideRule `json:"rule"`
@@ -687,6 +853,9 @@ type SearchOverrideSchema struct {
 	// Includes List of document `id`s that should be included in the search results with their corresponding `position`s.
 	Includes *[]SearchOverrideInclude `json:"includes,omitempty"`
 
+	// Metadata Return a custom JSON object in the Search API response, when this rule is triggered. This can be used to display a pre-defined message (eg: a promotion banner) on the front-end when a particular rule is triggered.
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+
 	// RemoveMatchedTokens Indicates whether search query tokens that exist in the override's rule should be removed from the search query.
 	RemoveMatchedTokens *bool              `json:"remove_matched_tokens,omitempty"`
 	Rule                SearchOverrideRule `json:"rule"`
@@ -702,15 +871,39 @@ type SearchParameters struct {
 	// CacheTtl The duration (in seconds) that determines how long the search query is cached. This value can be set on a per-query basis. Default: 60.
 	CacheTtl *int `json:"cache_ttl,omitempty"`
 
+	// Conversation Enable conversational search.
+	Conversation *bool `json:"conversation,omitempty"`
+
+	// ConversationId The Id of a previous conversation to continue, this tells Typesense to include prior context when communicating with the LLM.
+	ConversationId *string `json:"conversation_id,omitempty"`
+
+	// ConversationModelId The Id of Conversation Model to be used.
+	ConversationModelId *string `json:"conversation_model_id,omitempty"`
+
+	// ConversationStream Stream the conversational search answer as server-sent events.
+	ConversationStream *bool `json:"conversation_stream,omitempty"`
+
+	// DropTokensMode Dictates the direction in which the words in the query must be dropped when the original words in the query do not appear in any document. Values: right_to_left (default), left_to_right, both_sides:3. A note on both_sides:3 - for queries up to 3 tokens (words) in length, this mode will drop tokens from both sides and exhaustively rank all matching results. If query length is greater than 3 words, Typesense will just fallback to default behavior of right_to_left
+	DropTokensMode *string `json:"drop_tokens_mode,omitempty"`
+
 	// DropTokensThreshold If the number of results found for a specific query is less than this number, Typesense will attempt to drop the tokens in the query until enough results are found. Tokens that have the least individual hits are dropped first. Set to 0 to disable. Default: 10
 	DropTokensThreshold *int `json:"drop_tokens_threshold,omitempty"`
 
 	// EnableHighlightV1 Flag for enabling/disabling the deprecated, old highlight structure in the response. Default: true
 	EnableHighlightV1 *bool `json:"enable_highlight_v1,omitempty"`
 
+	// EnableLazyFilter Applies the filters lazily, evaluating them only against the documents that match the query tokens. Useful for expensive filters on large collections. Default: false.
+	EnableLazyFilter *bool `json:"enable_lazy_filter,omitempty"`
+
 	// EnableOverrides If you have some overrides defined but want to disable all of them during query time, you can do that by setting this parameter to false
 	EnableOverrides *bool `json:"enable_overrides,omitempty"`
 
+	// EnableSynonyms If you have some synonyms defined but want to disable all of them for a particular search query, set enable_synonyms to false. Default: true
+	EnableSynonyms *bool `json:"enable_synonyms,omitempty"`
+
+	// EnableTyposForAlphaNumericalTokens Set this parameter to false to disable typos on alphanumerical query tokens. Default: true.
+	EnableTyposForAlphaNumericalTokens *bool `json:"enable_typos_for_alpha_numerical_tokens,omitempty"`
+
 	// EnableTyposForNumericalTokens Make Typesense disable typos for numerical tokens.
 	EnableTyposForNumericalTokens *bool `json:"enable_typos_for_numerical_tokens,omitempty"`
 
@@ -735,6 +928,9 @@ type SearchParameters struct {
 	// FilterBy Filter conditions for refining youropen api validator search results. Separate multiple conditions with &&.
 	FilterBy *string `json:"filter_by,omitempty"`
 
+	// FilterCuratedHits Whether the filter_by condition of the search query should be applicable to curated results (override definitions, pinned hits, hidden hits, etc.). Default: false
+	FilterCuratedHits *bool `json:"filter_curated_hits,omitempty"`
+
 	// GroupBy You can aggregate search results into groups or buckets by specify one or more `group_by` fields. Separate multiple fields with a comma. To group on a particular field, it must be a faceted field.
 	GroupBy *string `json:"group_by,omitempty"`
 
@@ -781,12 +977,21 @@ type SearchParameters struct {
 	// MaxFacetValues Maximum number of facet values to be returned.
 	MaxFacetValues *int `json:"max_facet_values,omitempty"`
 
+	// MaxFilterByCandidates Controls the number of similar words that Typesense considers during fuzzy search on filter_by values. Useful for controlling prefix matches like company_name:Acm*.
+	MaxFilterByCandidates *int `json:"max_filter_by_candidates,omitempty"`
+
 	// MinLen1typo Minimum word length for 1-typo correction to be applied. The value of num_typos is still treated as the maximum allowed typos.
 	MinLen1typo *int `json:"min_len_1typo,omitempty"`
 
 	// MinLen2typo Minimum word length for 2-typo correction to be applied. The value of num_typos is still treated as the maximum allowed typos.
 	MinLen2typo *int `json:"min_len_2typo,omitempty"`
 
+	// NlModelId The ID of the natural language model to use for parsing the query.
+	NlModelId *string `json:"nl_model_id,omitempty"`
+
+	// NlQuery Set this parameter to true to parse the query with a natural language model into search parameters such as filter_by and sort_by.
+	NlQuery *bool `json:"nl_query,omitempty"`
+
 	// NumTypos The number of typographical errors (1 or 2) that would be tolerated. Default: 2
 	NumTypos *string `json:"num_typos,omitempty"`
 
@@ -840,6 +1045,9 @@ type SearchParameters struct {
 	// RemoteEmbeddingTimeoutMs Timeout (in milliseconds) for fetching remote embeddings.
 	RemoteEmbeddingTimeoutMs *int `json:"remote_embedding_timeout_ms,omitempty"`
 
+	// RerankHybridMatches When true, computes both text match and vector distance scores for all matches in hybrid search. Documents found only through keyword search will get a vector distance score, and documents found only through vector search will get a text match score. Default: false
+	RerankHybridMatches *bool `json:"rerank_hybrid_matches,omitempty"`
+
 	// SearchCutoffMs Typesense will attempt to return results early if the cutoff time has elapsed. This is not a strict guarantee and facet computation is not bound by this parameter.
 	SearchCutoffMs *int `json:"search_cutoff_ms,omitempty"`
 
@@ -855,6 +1063,15 @@ type SearchParameters struct {
 	// Stopwords Name of the stopwords set to apply for this search, the keywords present in the set will be removed from the search query.
 	Stopwords *string `json:"stopwords,omitempty"`
 
+	// SynonymNumTypos Allow synonym resolution on typo-corrected words in the query. Default: 0
+	SynonymNumTypos *int `json:"synonym_num_typos,omitempty"`
+
+	// SynonymPrefix Allow synonym resolution on word prefixes in the query. Default: false
+	SynonymPrefix *bool `json:"synonym_prefix,omitempty"`
+
+	// SynonymSets Comma separated list of synonym set names to apply to the search query.
+	SynonymSets *string `json:"synonym_sets,omitempty"`
+
 	// TextMatchType In a multi-field matching context, this parameter determines how the representative text match score of a record is calculated. Possible values are max_score (default) or max_weight.
 	TextMatchType *string `json:"text_match_type,omitempty"`
 
@@ -866,6 +1083,9 @@ type SearchParameters struct {
 
 	// VectorQuery Vector query expression for fetching documents "closest" to a given query/document vector.
 	VectorQuery *string `json:"vector_query,omitempty"`
+
+	// VoiceQuery The base64 encoded audio file in 16 khz 16-bit WAV format.
+	VoiceQuery *string `json:"voice_query,omitempty"`
 }
 
 // SearchResult defines model for SearchResult.
@@ -873,7 +1093,8 @@ type SearchResult struct {
 	Code *int `json:"code,omitempty"`
 	Error *string `json:"error,omitempty"`
 
-	FacetCounts *[]FacetCounts `json:"facet_counts,omitempty"`
+	Conversation *SearchResultConversation `json:"conversation,omitempty"`
+	FacetCounts  *[]FacetCounts            `json:"facet_counts,omitempty"`
 
 	// Found The number of documents found
 	Found       *int                `json:"found,omitempty"`
@@ -882,15 +1103,35 @@ type SearchResult struct {
 	// Hits The documents that matched the search query
 	Hits *[]SearchResultHit `json:"hits,omitempty"`
 
+	// Metadata Custom JSON object that can be returned in the search response
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+
 	// OutOf The total number of documents in the collection
 	OutOf *int `json:"out_of,omitempty"`
 
 	// Page The search result page number
-	Page          *int `json:"page,omitempty"`
+	Page *int `json:"page,omitempty"`
+
+	// ParsedNlQuery The search parameters generated from a natural language query
+	ParsedNlQuery *struct {
+		// AugmentedParams The search parameters used for the search, with the generated parameters merged into the request parameters
+		AugmentedParams *map[string]interface{} `json:"augmented_params,omitempty"`
+
+		// GeneratedParams The search parameters generated by the natural language model
+		GeneratedParams *map[string]interface{} `json:"generated_params,omitempty"`
+
+		// ParseTimeMs The number of milliseconds parsing the query took
+		ParseTimeMs *int `json:"parse_time_ms,omitempty"`
+	} `json:"parsed_nl_query,omitempty"`
 	RequestParams *struct {
 		CollectionName string `json:"collection_name"`
 		PerPage        int    `json:"per_page"`
 		Q              string `json:"q"`
+
+		// VoiceQuery The transcription of the voice query of a voice search
+		VoiceQuery *struct {
+			TranscribedQuery *string `json:"transcribed_query,omitempty"`
+		} `json:"voice_query,omitempty"`
 	} `json:"request_params,omitempty"`
 
 	// SearchCutoff Whether the search was cut off
@@ -900,8 +1141,19 @@ type SearchResult struct {
 	SearchTimeMs *int `json:"search_time_ms,omitempty"`
 }
 
+// SearchResultConversation defines model for SearchResultConversation.
+type SearchResultConversation struct {
+	Answer              string                   `json:"answer"`
+	ConversationHistory []map[string]interface{} `json:"conversation_history"`
+	ConversationId      string                   `json:"conversation_id"`
+	Query               string                   `json:"query"`
+}
+
 // SearchResultHit defines model for SearchResultHit.
 type SearchResultHit struct {
+	// Curated Set to true when the hit was included by an override (pinned or added by a curation rule). Not set for organic hits.
+	Curated *bool `json:"curated,omitempty"`
+
 	// Document Can be any key-value pair
 	Document *map[string]interface{} `json:"document,omitempty"`
 
@@ -915,10 +1167,24 @@ type SearchResultHit struct {
 	Highlights *[]SearchHighlight `json:"highlights,omitempty"`
 	TextMatch  *int64             `json:"text_match,omitempty"`
 
+	// TextMatchInfo Breakdown of the text match score of a hit
+	TextMatchInfo *SearchResultHitTextMatchInfo `json:"text_match_info,omitempty"`
+
 	// VectorDistance Distance between the query vector and matching document's vector value
 	VectorDistance *float32 `json:"vector_distance,omitempty"`
 }
 
+// SearchResultHitTextMatchInfo Breakdown of the text match score of a hit
+type SearchResultHitTextMatchInfo struct {
+	BestFieldScore   *string `json:"best_field_score,omitempty"`
+	BestFieldWeight  *int    `json:"best_field_weight,omitempty"`
+	FieldsMatched    *int    `json:"fields_matched,omitempty"`
+	NumTokensDropped *int64  `json:"num_tokens_dropped,omitempty"`
+	Score            *string `json:"score,omitempty"`
+	TokensMatched    *int    `json:"tokens_matched,omitempty"`
+	TypoPrefixScore  *int    `json:"typo_prefix_score,omitempty"`
+}
+
 // SearchSynonym defines model for SearchSynonym.
 type SearchSynonym struct {
 	Id *string `json:"id,omitempty"`
@@ -972,10 +1238,16 @@ type SuccessStatus struct {
 	Success bool `json:"success"`
 }
 
+// VoiceQueryModelCollectionConfig Configuration for the voice query model
+type VoiceQueryModelCollectionConfig struct {
+	ModelName *string `json:"model_name,omitempty"`
+}
+
 // DeleteDocumentsParams defines parameters for DeleteDocuments.
 type DeleteDocumentsParams struct {
 	BatchSize *int    `form:"batch_size,omitempty" json:"batch_size,omitempty"`
 	FilterBy  *string `form:"filter_by,omitempty" json:"filter_by,omitempty"`
+	Truncate  *bool   `form:"truncate,omitempty" json:"truncate,omitempty"`
 }
 
 // UpdateDocumentsJSONBody defines parameters for UpdateDocuments.
@@ -1011,6 +1283,7 @@ type ImportDocumentsParams struct {
 	BatchSize                *int                              `form:"batch_size,omitempty" json:"batch_size,omitempty"`
 	DirtyValues              *ImportDocumentsParamsDirtyValues `form:"dirty_values,omitempty" json:"dirty_values,omitempty"`
 	RemoteEmbeddingBatchSize *int                              `form:"remote_embedding_batch_size,omitempty" json:"remote_embedding_batch_size,omitempty"`
+	RemoteEmbeddingNumTries  *int                              `form:"remote_embedding_num_tries,omitempty" json:"remote_embedding_num_tries,omitempty"`
 }
 
 // ImportDocumentsParamsDirtyValues defines parameters for ImportDocuments.
@@ -1018,61 +1291,84 @@ type ImportDocumentsParamsDirtyValues string
 
 // SearchCollectionParams defines parameters for SearchCollection.
 type SearchCollectionParams struct {
-	CacheTtl                      *int    `form:"cache_ttl,omitempty" json:"cache_ttl,omitempty"`
-	DropTokensThreshold           *int    `form:"drop_tokens_threshold,omitempty" json:"drop_tokens_threshold,omitempty"`
-	EnableHighlightV1             *bool   `form:"enable_highlight_v1,omitempty" json:"enable_highlight_v1,omitempty"`
-	EnableOverrides               *bool   `form:"enable_overrides,omitempty" json:"enable_overrides,omitempty"`
-	EnableTyposForNumericalTokens *bool   `form:"enable_typos_for_numerical_tokens,omitempty" json:"enable_typos_for_numerical_tokens,omitempty"`
-	ExcludeFields                 *string `form:"exclude_fields,omitempty" json:"exclude_fields,omitempty"`
-	ExhaustiveSearch              *bool   `form:"exhaustive_search,omitempty" json:"exhaustive_search,omitempty"`
-	FacetBy                       *string `form:"facet_by,omitempty" json:"facet_by,omitempty"`
-	FacetQuery                    *string `form:"facet_query,omitempty" json:"facet_query,omitempty"`
-	FacetReturnParent             *string `form:"facet_return_parent,omitempty" json:"facet_return_parent,omitempty"`
-	FacetStrategy                 *string `form:"facet_strategy,omitempty" json:"facet_strategy,omitempty"`
-	FilterBy                      *string `form:"filter_by,omitempty" json:"filter_by,omitempty"`
-	GroupBy                       *string `form:"group_by,omitempty" json:"group_by,omitempty"`
-	GroupLimit                    *int    `form:"group_limit,omitempty" json:"group_limit,omitempty"`
-	HiddenHits                    *string `form:"hidden_hits,omitempty" json:"hidden_hits,omitempty"`
-	HighlightAffixNumTokens       *int    `form:"highlight_affix_num_tokens,omitempty" json:"highlight_affix_num_tokens,omitempty"`
-	HighlightEndTag               *string `form:"highlight_end_tag,omitempty" json:"highlight_end_tag,omitempty"`
-	HighlightFields               *string `form:"highlight_fields,omitempty" json:"highlight_fields,omitempty"`
-	HighlightFullFields           *string `form:"highlight_full_fields,omitempty" json:"highlight_full_fields,omitempty"`
-	HighlightStartTag             *string `form:"highlight_start_tag,omitempty" json:"highlight_start_tag,omitempty"`
-	IncludeFields                 *string `form:"include_fields,omitempty" json:"include_fields,omitempty"`
-	Infix                         *string `form:"infix,omitempty" json:"infix,omitempty"`
-	Limit                         *int    `form:"limit,omitempty" json:"limit,omitempty"`
-	MaxCandidates                 *int    `form:"max_candidates,omitempty" json:"max_candidates,omitempty"`
-	MaxExtraPrefix                *int    `form:"max_extra_prefix,omitempty" json:"max_extra_prefix,omitempty"`
-	MaxExtraSuffix                *int    `form:"max_extra_suffix,omitempty" json:"max_extra_suffix,omitempty"`
-	MaxFacetValues                *int    `form:"max_facet_values,omitempty" json:"max_facet_values,omitempty"`
-	MinLen1typo                   *int    `form:"min_len_1typo,omitempty" json:"min_len_1typo,omitempty"`
-	MinLen2typo                   *int    `form:"min_len_2typo,omitempty" json:"min_len_2typo,omitempty"`
-	NumTypos                      *string `form:"num_typos,omitempty" json:"num_typos,omitempty"`
-	Offset                        *int    `form:"offset,omitempty" json:"offset,omitempty"`
-	OverrideTags                  *string `form:"override_tags,omitempty" json:"override_tags,omitempty"`
-	Page                          *int    `form:"page,omitempty" json:"page,omitempty"`
-	PerPage                       *int    `form:"per_page,omitempty" json:"per_page,omitempty"`
-	PinnedHits                    *string `form:"pinned_hits,omitempty" json:"pinned_hits,omitempty"`
-	PreSegmentedQuery             *bool   `form:"pre_segmented_query,omitempty" json:"pre_segmented_query,omitempty"`
-	Prefix                        *string `form:"prefix,omitempty" json:"prefix,omitempty"`
-	Preset                        *string `form:"preset,omitempty" json:"preset,omitempty"`
-	PrioritizeExactMatch          *bool   `form:"prioritize_exact_match,omitempty" json:"prioritize_exact_match,omitempty"`
-	PrioritizeNumMatchingFields   *bool   `form:"prioritize_num_matching_fields,omitempty" json:"prioritize_num_matching_fields,omitempty"`
-	PrioritizeTokenPosition       *bool   `form:"prioritize_token_position,omitempty" json:"prioritize_token_position,omitempty"`
-	Q                             *string `form:"q,omitempty" json:"q,omitempty"`
-	QueryBy                       *string `form:"query_by,omitempty" json:"query_by,omitempty"`
-	QueryByWeights                *string `form:"query_by_weights,omitempty" json:"query_by_weights,omitempty"`
-	RemoteEmbeddingNumTries       *int    `form:"remote_embedding_num_tries,omitempty" json:"remote_embedding_num_tries,omitempty"`
-	RemoteEmbeddingTimeoutMs      *int    `form:"remote_embedding_timeout_ms,omitempty" json:"remote_embedding_timeout_ms,omitempty"`
-	SearchCutoffMs                *int    `form:"search_cutoff_ms,omitempty" json:"search_cutoff_ms,omitempty"`
-	SnippetThreshold              *int    `form:"snippet_threshold,omitempty" json:"snippet_threshold,omitempty"`
-	SortBy                        *string `form:"sort_by,omitempty" json:"sort_by,omitempty"`
-	SplitJoinTokens               *string `form:"split_join_tokens,omitempty" json:"split_join_tokens,omitempty"`
-	Stopwords                     *string `form:"stopwords,omitempty" json:"stopwords,omitempty"`
-	TextMatchType                 *string `form:"text_match_type,omitempty" json:"text_match_type,omitempty"`
-	TypoTokensThreshold           *int    `form:"typo_tokens_threshold,omitempty" json:"typo_tokens_threshold,omitempty"`
-	UseCache                      *bool   `form:"use_cache,omitempty" json:"use_cache,omitempty"`
-	VectorQuery                   *string `form:"vector_query,omitempty" json:"vector_query,omitempty"`
+	CacheTtl                           *int    `form:"cache_ttl,omitempty" json:"cache_ttl,omitempty"`
+	Conversation                       *bool   `form:"conversation,omitempty" json:"conversation,omitempty"`
+	ConversationId                     *string `form:"conversation_id,omitempty" json:"conversation_id,omitempty"`
+	ConversationModelId                *string `form:"conversation_model_id,omitempty" json:"conversation_model_id,omitempty"`
+	ConversationStream                 *bool   `form:"conversation_stream,omitempty" json:"conversation_stream,omitempty"`
+	DropTokensMode                     *string `form:"drop_tokens_mode,omitempty" json:"drop_tokens_mode,omitempty"`
+	DropTokensThreshold                *int    `form:"drop_tokens_threshold,omitempty" json:"drop_tokens_threshold,omitempty"`
+	EnableHighlightV1                  *bool   `form:"enable_highlight_v1,omitempty" json:"enable_highlight_v1,omitempty"`
+	EnableLazyFilter                   *bool   `form:"enable_lazy_filter,omitempty" json:"enable_lazy_filter,omitempty"`
+	EnableOverrides                    *bool   `form:"enable_overrides,omitempty" json:"enable_overrides,omitempty"`
+	EnableSynonyms                     *bool   `form:"enable_synonyms,omitempty" json:"enable_synonyms,omitempty"`
+	EnableTyposForAlphaNumericalTokens *bool   `form:"enable_typos_for_alpha_numerical_tokens,omitempty" json:"enable_typos_for_alpha_numerical_tokens,omitempty"`
+	EnableTyposForNumericalTokens      *bool   `form:"enable_typos_for_numerical_tokens,omitempty" json:"enable_typos_for_numerical_tokens,omitempty"`
+	ExcludeFields                      *string `form:"exclude_fields,omitempty" json:"exclude_fields,omitempty"`
+	ExhaustiveSearch                   *bool   `form:"exhaustive_search,omitempty" json:"exhaustive_search,omitempty"`
+	FacetBy                            *string `form:"facet_by,omitempty" json:"facet_by,omitempty"`
+	FacetQuery                         *string `form:"facet_query,omitempty" json:"facet_query,omitempty"`
+	FacetReturnParent                  *string `form:"facet_return_parent,omitempty" json:"facet_return_parent,omitempty"`
+	FacetStrategy                      *string `form:"facet_strategy,omitempty" json:"facet_strategy,omitempty"`
+	FilterBy                           *string `form:"filter_by,omitempty" json:"filter_by,omitempty"`
+	FilterCuratedHits                  *bool   `form:"filter_curated_hits,omitempty" json:"filter_curated_hits,omitempty"`
+	GroupBy                            *string `form:"group_by,omitempty" json:"group_by,omitempty"`
+	GroupLimit                         *int    `form:"group_limit,omitempty" json:"group_limit,omitempty"`
+	HiddenHits                         *string `form:"hidden_hits,omitempty" json:"hidden_hits,omitempty"`
+	HighlightAffixNumTokens            *int    `form:"highlight_affix_num_tokens,omitempty" json:"highlight_affix_num_tokens,omitempty"`
+	HighlightEndTag                    *string `form:"highlight_end_tag,omitempty" json:"highlight_end_tag,omitempty"`
+	HighlightFields                    *string `form:"highlight_fields,omitempty" json:"highlight_fields,omitempty"`
+	HighlightFullFields                *string `form:"highlight_full_fields,omitempty" json:"highlight_full_fields,omitempty"`
+	HighlightStartTag                  *string `form:"highlight_start_tag,omitempty" json:"highlight_start_tag,omitempty"`
+	IncludeFields                      *string `form:"include_fields,omitempty" json:"include_fields,omitempty"`
+	Infix                              *string `form:"infix,omitempty" json:"infix,omitempty"`
+	Limit                              *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	MaxCandidates                      *int    `form:"max_candidates,omitempty" json:"max_candidates,omitempty"`
+	MaxExtraPrefix                     *int    `form:"max_extra_prefix,omitempty" json:"max_extra_prefix,omitempty"`
+	MaxExtraSuffix                     *int    `form:"max_extra_suffix,omitempty" json:"max_extra_suffix,omitempty"`
+	MaxFacetValues                     *int    `form:"max_facet_values,omitempty" json:"max_facet_values,omitempty"`
+	MaxFilterByCandidates              *int    `form:"max_filter_by_candidates,omitempty" json:"max_filter_by_candidates,omitempty"`
+	MinLen1typo                        *int    `form:"min_len_1typo,omitempty" json:"min_len_1typo,omitempty"`
+	MinLen2typo                        *int    `form:"min_len_2typo,omitempty" json:"min_len_2typo,omitempty"`
+	NlModelId                          *string `form:"nl_model_id,omitempty" json:"nl_model_id,omitempty"`
+	NlQuery                            *bool   `form:"nl_query,omitempty" json:"nl_query,omitempty"`
+	NumTypos                           *string `form:"num_typos,omitempty" json:"num_typos,omitempty"`
+	Offset                             *int    `form:"offset,omitempty" json:"offset,omitempty"`
+	OverrideTags                       *string `form:"override_tags,omitempty" json:"override_tags,omitempty"`
+	Page                               *int    `form:"page,omitempty" json:"page,omitempty"`
+	PerPage                            *int    `form:"per_page,omitempty" json:"per_page,omitempty"`
+	PinnedHits                         *string `form:"pinned_hits,omitempty" json:"pinned_hits,omitempty"`
+	PreSegmentedQuery                  *bool   `form:"pre_segmented_query,omitempty" json:"pre_segmented_query,omitempty"`
+	Prefix                             *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+	Preset                             *string `form:"preset,omitempty" json:"preset,omitempty"`
+	PrioritizeExactMatch               *bool   `form:"prioritize_exact_match,omitempty" json:"prioritize_exact_match,omitempty"`
+	PrioritizeNumMatchingFields        *bool   `form:"prioritize_num_matching_fields,omitempty" json:"prioritize_num_matching_fields,omitempty"`
+	PrioritizeTokenPosition            *bool   `form:"prioritize_token_position,omitempty" json:"prioritize_token_position,omitempty"`
+	Q                                  *string `form:"q,omitempty" json:"q,omitempty"`
+	QueryBy                            *string `form:"query_by,omitempty" json:"query_by,omitempty"`
+	QueryByWeights                     *string `form:"query_by_weights,omitempty" json:"query_by_weights,omitempty"`
+	RemoteEmbeddingNumTries            *int    `form:"remote_embedding_num_tries,omitempty" json:"remote_embedding_num_tries,omitempty"`
+	RemoteEmbeddingTimeoutMs           *int    `form:"remote_embedding_timeout_ms,omitempty" json:"remote_embedding_timeout_ms,omitempty"`
+	RerankHybridMatches                *bool   `form:"rerank_hybrid_matches,omitempty" json:"rerank_hybrid_matches,omitempty"`
+	SearchCutoffMs                     *int    `form:"search_cutoff_ms,omitempty" json:"search_cutoff_ms,omitempty"`
+	SnippetThreshold                   *int    `form:"snippet_threshold,omitempty" json:"snippet_threshold,omitempty"`
+	SortBy                             *string `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+	SplitJoinTokens                    *string `form:"split_join_tokens,omitempty" json:"split_join_tokens,omitempty"`
+	Stopwords                          *string `form:"stopwords,omitempty" json:"stopwords,omitempty"`
+	SynonymNumTypos                    *int    `form:"synonym_num_typos,omitempty" json:"synonym_num_typos,omitempty"`
+	SynonymPrefix                      *bool   `form:"synonym_prefix,omitempty" json:"synonym_prefix,omitempty"`
+	SynonymSets                        *string `form:"synonym_sets,omitempty" json:"synonym_sets,omitempty"`
+	TextMatchType                      *string `form:"text_match_type,omitempty" json:"text_match_type,omitempty"`
+	TypoTokensThreshold                *int    `form:"typo_tokens_threshold,omitempty" json:"typo_tokens_threshold,omitempty"`
+	UseCache                           *bool   `form:"use_cache,omitempty" json:"use_cache,omitempty"`
+	VectorQuery                        *string `form:"vector_query,omitempty" json:"vector_query,omitempty"`
+	VoiceQuery                         *string `form:"voice_query,omitempty" json:"voice_query,omitempty"`
+}
+
+// GetDocumentParams defines parameters for GetDocument.
+type GetDocumentParams struct {
+	ExcludeFields *string `form:"exclude_fields,omitempty" json:"exclude_fields,omitempty"`
+	IncludeFields *string `form:"include_fields,omitempty" json:"include_fields,omitempty"`
 }
 
 // UpdateDocumentJSONBody defines parameters for UpdateDocument.
@@ -1080,61 +1376,78 @@ type UpdateDocumentJSONBody = interface{}
 
 // MultiSearchParams defines parameters for MultiSearch.
 type MultiSearchParams struct {
-	CacheTtl                      *int    `form:"cache_ttl,omitempty" json:"cache_ttl,omitempty"`
-	DropTokensThreshold           *int    `form:"drop_tokens_threshold,omitempty" json:"drop_tokens_threshold,omitempty"`
-	EnableHighlightV1             *bool   `form:"enable_highlight_v1,omitempty" json:"enable_highlight_v1,omitempty"`
-	EnableOverrides               *bool   `form:"enable_overrides,omitempty" json:"enable_overrides,omitempty"`
-	EnableTyposForNumericalTokens *bool   `form:"enable_typos_for_numerical_tokens,omitempty" json:"enable_typos_for_numerical_tokens,omitempty"`
-	ExcludeFields                 *string `form:"exclude_fields,omitempty" json:"exclude_fields,omitempty"`
-	ExhaustiveSearch              *bool   `form:"exhaustive_search,omitempty" json:"exhaustive_search,omitempty"`
-	FacetBy                       *string `form:"facet_by,omitempty" json:"facet_by,omitempty"`
-	FacetQuery                    *string `form:"facet_query,omitempty" json:"facet_query,omitempty"`
-	FacetReturnParent             *string `form:"facet_return_parent,omitempty" json:"facet_return_parent,omitempty"`
-	FacetStrategy                 *string `form:"facet_strategy,omitempty" json:"facet_strategy,omitempty"`
-	FilterBy                      *string `form:"filter_by,omitempty" json:"filter_by,omitempty"`
-	GroupBy                       *string `form:"group_by,omitempty" json:"group_by,omitempty"`
-	GroupLimit                    *int    `form:"group_limit,omitempty" json:"group_limit,omitempty"`
-	HiddenHits                    *string `form:"hidden_hits,omitempty" json:"hidden_hits,omitempty"`
-	HighlightAffixNumTokens       *int    `form:"highlight_affix_num_tokens,omitempty" json:"highlight_affix_num_tokens,omitempty"`
-	HighlightEndTag               *string `form:"highlight_end_tag,omitempty" json:"highlight_end_tag,omitempty"`
-	HighlightFields               *string `form:"highlight_fields,omitempty" json:"highlight_fields,omitempty"`
-	HighlightFullFields           *string `form:"highlight_full_fields,omitempty" json:"highlight_full_fields,omitempty"`
-	HighlightStartTag             *string `form:"highlight_start_tag,omitempty" json:"highlight_start_tag,omitempty"`
-	IncludeFields                 *string `form:"include_fields,omitempty" json:"include_fields,omitempty"`
-	Infix                         *string `form:"infix,omitempty" json:"infix,omitempty"`
-	Limit                         *int    `form:"limit,omitempty" json:"limit,omitempty"`
-	MaxCandidates                 *int    `form:"max_candidates,omitempty" json:"max_candidates,omitempty"`
-	MaxExtraPrefix                *int    `form:"max_extra_prefix,omitempty" json:"max_extra_prefix,omitempty"`
-	MaxExtraSuffix                *int    `form:"max_extra_suffix,omitempty" json:"max_extra_suffix,omitempty"`
-	MaxFacetValues                *int    `form:"max_facet_values,omitempty" json:"max_facet_values,omitempty"`
-	MinLen1typo                   *int    `form:"min_len_1typo,omitempty" json:"min_len_1typo,omitempty"`
-	MinLen2typo                   *int    `form:"min_len_2typo,omitempty" json:"min_len_2typo,omitempty"`
-	NumTypos                      *string `form:"num_typos,omitempty" json:"num_typos,omitempty"`
-	Offset                        *int    `form:"offset,omitempty" json:"offset,omitempty"`
-	OverrideTags                  *string `form:"override_tags,omitempty" json:"override_tags,omitempty"`
-	Page                          *int    `form:"page,omitempty" json:"page,omitempty"`
-	PerPage                       *int    `form:"per_page,omitempty" json:"per_page,omitempty"`
-	PinnedHits                    *string `form:"pinned_hits,omitempty" json:"pinned_hits,omitempty"`
-	PreSegmentedQuery             *bool   `form:"pre_segmented_query,omitempty" json:"pre_segmented_query,omitempty"`
-	Prefix                        *string `form:"prefix,omitempty" json:"prefix,omitempty"`
-	Preset                        *string `form:"preset,omitempty" json:"preset,omitempty"`
-	PrioritizeExactMatch          *bool   `form:"prioritize_exact_match,omitempty" json:"prioritize_exact_match,omitempty"`
-	PrioritizeNumMatchingFields   *bool   `form:"prioritize_num_matching_fields,omitempty" json:"prioritize_num_matching_fields,omitempty"`
-	PrioritizeTokenPosition       *bool   `form:"prioritize_token_position,omitempty" json:"prioritize_token_position,omitempty"`
-	Q                             *string `form:"q,omitempty" json:"q,omitempty"`
-	QueryBy                       *string `form:"query_by,omitempty" json:"query_by,omitempty"`
-	QueryByWeights                *string `form:"query_by_weights,omitempty" json:"query_by_weights,omitempty"`
-	RemoteEmbeddingNumTries       *int    `form:"remote_embedding_num_tries,omitempty" json:"remote_embedding_num_tries,omitempty"`
-	RemoteEmbeddingTimeoutMs      *int    `form:"remote_embedding_timeout_ms,omitempty" json:"remote_embedding_timeout_ms,omitempty"`
-	SearchCutoffMs                *int    `form:"search_cutoff_ms,omitempty" json:"search_cutoff_ms,omitempty"`
-	SnippetThreshold              *int    `form:"snippet_threshold,omitempty" json:"snippet_threshold,omitempty"`
-	SortBy                        *string `form:"sort_by,omitempty" json:"sort_by,omitempty"`
-	SplitJoinTokens               *string `form:"split_join_tokens,omitempty" json:"split_join_tokens,omitempty"`
-	Stopwords                     *string `form:"stopwords,omitempty" json:"stopwords,omitempty"`
-	TextMatchType                 *string `form:"text_match_type,omitempty" json:"text_match_type,omitempty"`
-	TypoTokensThreshold           *int    `form:"typo_tokens_threshold,omitempty" json:"typo_tokens_threshold,omitempty"`
-	UseCache                      *bool   `form:"use_cache,omitempty" json:"use_cache,omitempty"`
-	VectorQuery                   *string `form:"vector_query,omitempty" json:"vector_query,omitempty"`
+	CacheTtl                           *int    `form:"cache_ttl,omitempty" json:"cache_ttl,omitempty"`
+	Conversation                       *bool   `form:"conversation,omitempty" json:"conversation,omitempty"`
+	ConversationId                     *string `form:"conversation_id,omitempty" json:"conversation_id,omitempty"`
+	ConversationModelId                *string `form:"conversation_model_id,omitempty" json:"conversation_model_id,omitempty"`
+	ConversationStream                 *bool   `form:"conversation_stream,omitempty" json:"conversation_stream,omitempty"`
+	DropTokensMode                     *string `form:"drop_tokens_mode,omitempty" json:"drop_tokens_mode,omitempty"`
+	DropTokensThreshold                *int    `form:"drop_tokens_threshold,omitempty" json:"drop_tokens_threshold,omitempty"`
+	EnableHighlightV1                  *bool   `form:"enable_highlight_v1,omitempty" json:"enable_highlight_v1,omitempty"`
+	EnableLazyFilter                   *bool   `form:"enable_lazy_filter,omitempty" json:"enable_lazy_filter,omitempty"`
+	EnableOverrides                    *bool   `form:"enable_overrides,omitempty" json:"enable_overrides,omitempty"`
+	EnableSynonyms                     *bool   `form:"enable_synonyms,omitempty" json:"enable_synonyms,omitempty"`
+	EnableTyposForAlphaNumericalTokens *bool   `form:"enable_typos_for_alpha_numerical_tokens,omitempty" json:"enable_typos_for_alpha_numerical_tokens,omitempty"`
+	EnableTyposForNumericalTokens      *bool   `form:"enable_typos_for_numerical_tokens,omitempty" json:"enable_typos_for_numerical_tokens,omitempty"`
+	ExcludeFields                      *string `form:"exclude_fields,omitempty" json:"exclude_fields,omitempty"`
+	ExhaustiveSearch                   *bool   `form:"exhaustive_search,omitempty" json:"exhaustive_search,omitempty"`
+	FacetBy                            *string `form:"facet_by,omitempty" json:"facet_by,omitempty"`
+	FacetQuery                         *string `form:"facet_query,omitempty" json:"facet_query,omitempty"`
+	FacetReturnParent                  *string `form:"facet_return_parent,omitempty" json:"facet_return_parent,omitempty"`
+	FacetStrategy                      *string `form:"facet_strategy,omitempty" json:"facet_strategy,omitempty"`
+	FilterBy                           *string `form:"filter_by,omitempty" json:"filter_by,omitempty"`
+	FilterCuratedHits                  *bool   `form:"filter_curated_hits,omitempty" json:"filter_curated_hits,omitempty"`
+	GroupBy                            *string `form:"group_by,omitempty" json:"group_by,omitempty"`
+	GroupLimit                         *int    `form:"group_limit,omitempty" json:"group_limit,omitempty"`
+	HiddenHits                         *string `form:"hidden_hits,omitempty" json:"hidden_hits,omitempty"`
+	HighlightAffixNumTokens            *int    `form:"highlight_affix_num_tokens,omitempty" json:"highlight_affix_num_tokens,omitempty"`
+	HighlightEndTag                    *string `form:"highlight_end_tag,omitempty" json:"highlight_end_tag,omitempty"`
+	HighlightFields                    *string `form:"highlight_fields,omitempty" json:"highlight_fields,omitempty"`
+	HighlightFullFields                *string `form:"highlight_full_fields,omitempty" json:"highlight_full_fields,omitempty"`
+	HighlightStartTag                  *string `form:"highlight_start_tag,omitempty" json:"highlight_start_tag,omitempty"`
+	IncludeFields                      *string `form:"include_fields,omitempty" json:"include_fields,omitempty"`
+	Infix                              *string `form:"infix,omitempty" json:"infix,omitempty"`
+	Limit                              *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	MaxCandidates                      *int    `form:"max_candidates,omitempty" json:"max_candidates,omitempty"`
+	MaxExtraPrefix                     *int    `form:"max_extra_prefix,omitempty" json:"max_extra_prefix,omitempty"`
+	MaxExtraSuffix                     *int    `form:"max_extra_suffix,omitempty" json:"max_extra_suffix,omitempty"`
+	MaxFacetValues                     *int    `form:"max_facet_values,omitempty" json:"max_facet_values,omitempty"`
+	MaxFilterByCandidates              *int    `form:"max_filter_by_candidates,omitempty" json:"max_filter_by_candidates,omitempty"`
+	MinLen1typo                        *int    `form:"min_len_1typo,omitempty" json:"min_len_1typo,omitempty"`
+	MinLen2typo                        *int    `form:"min_len_2typo,omitempty" json:"min_len_2typo,omitempty"`
+	NlModelId                          *string `form:"nl_model_id,omitempty" json:"nl_model_id,omitempty"`
+	NlQuery                            *bool   `form:"nl_query,omitempty" json:"nl_query,omitempty"`
+	NumTypos                           *string `form:"num_typos,omitempty" json:"num_typos,omitempty"`
+	Offset                             *int    `form:"offset,omitempty" json:"offset,omitempty"`
+	OverrideTags                       *string `form:"override_tags,omitempty" json:"override_tags,omitempty"`
+	Page                               *int    `form:"page,omitempty" json:"page,omitempty"`
+	PerPage                            *int    `form:"per_page,omitempty" json:"per_page,omitempty"`
+	PinnedHits                         *string `form:"pinned_hits,omitempty" json:"pinned_hits,omitempty"`
+	PreSegmentedQuery                  *bool   `form:"pre_segmented_query,omitempty" json:"pre_segmented_query,omitempty"`
+	Prefix                             *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+	Preset                             *string `form:"preset,omitempty" json:"preset,omitempty"`
+	PrioritizeExactMatch               *bool   `form:"prioritize_exact_match,omitempty" json:"prioritize_exact_match,omitempty"`
+	PrioritizeNumMatchingFields        *bool   `form:"prioritize_num_matching_fields,omitempty" json:"prioritize_num_matching_fields,omitempty"`
+	PrioritizeTokenPosition            *bool   `form:"prioritize_token_position,omitempty" json:"prioritize_token_position,omitempty"`
+	Q                                  *string `form:"q,omitempty" json:"q,omitempty"`
+	QueryBy                            *string `form:"query_by,omitempty" json:"query_by,omitempty"`
+	QueryByWeights                     *string `form:"query_by_weights,omitempty" json:"query_by_weights,omitempty"`
+	RemoteEmbeddingNumTries            *int    `form:"remote_embedding_num_tries,omitempty" json:"remote_embedding_num_tries,omitempty"`
+	RemoteEmbeddingTimeoutMs           *int    `form:"remote_embedding_timeout_ms,omitempty" json:"remote_embedding_timeout_ms,omitempty"`
+	RerankHybridMatches                *bool   `form:"rerank_hybrid_matches,omitempty" json:"rerank_hybrid_matches,omitempty"`
+	SearchCutoffMs                     *int    `form:"search_cutoff_ms,omitempty" json:"search_cutoff_ms,omitempty"`
+	SnippetThreshold                   *int    `form:"snippet_threshold,omitempty" json:"snippet_threshold,omitempty"`
+	SortBy                             *string `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+	SplitJoinTokens                    *string `form:"split_join_tokens,omitempty" json:"split_join_tokens,omitempty"`
+	Stopwords                          *string `form:"stopwords,omitempty" json:"stopwords,omitempty"`
+	SynonymNumTypos                    *int    `form:"synonym_num_typos,omitempty" json:"synonym_num_typos,omitempty"`
+	SynonymPrefix                      *bool   `form:"synonym_prefix,omitempty" json:"synonym_prefix,omitempty"`
+	SynonymSets                        *string `form:"synonym_sets,omitempty" json:"synonym_sets,omitempty"`
+	TextMatchType                      *string `form:"text_match_type,omitempty" json:"text_match_type,omitempty"`
+	TypoTokensThreshold                *int    `form:"typo_tokens_threshold,omitempty" json:"typo_tokens_threshold,omitempty"`
+	UseCache                           *bool   `form:"use_cache,omitempty" json:"use_cache,omitempty"`
+	VectorQuery                        *string `form:"vector_query,omitempty" json:"vector_query,omitempty"`
+	VoiceQuery                         *string `form:"voice_query,omitempty" json:"voice_query,omitempty"`
 }
 
 // TakeSnapshotParams defines parameters for TakeSnapshot.
@@ -3,14 +3,22 @@ package typesense
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/sony/gobreaker"
 	"github.com/typesense/typesense-go/v2/typesense/api/circuit"
 )
 
+// APICall is safe for concurrent use by multiple goroutines.
 type APICall struct {
+	// mu guards the nodes health state and currentNodeIndex
+	mu                   sync.Mutex
 	client               circuit.HTTPRequestDoer
 	nearestNode          *Node
 	nodes                []Node
@@ -18,17 +26,36 @@ type APICall struct {
 	healthcheckInterval  time.Duration
 	numRetriesPerRequest int
 	retryInterval        time.Duration
+	warningHandler       WarningHandlerFunc
+	maxResponseBytes     int64
+	// noRetryOperations are the operations sent only once, see WithDisableRetryFor
+	noRetryOperations map[string]bool
 }
 
+// WarningHandlerFunc is called with each non-fatal warning returned by the server
+type WarningHandlerFunc func(warning string)
+
+// warningHeader is the response header carrying server warnings
+const warningHeader = "Warning"
+
 type Node struct {
 	isHealthy           bool
 	index               interface{}
 	url                 string
 	lastAccessTimestamp int64
+	// breaker is the circuit breaker of the node, nil unless enabled with WithCircuitBreaker
+	breaker circuit.Breaker
 }
 
 var apiCallTimeNow = time.Now // for test stubbing
 
+// defaultNodeCircuitBreakerFailures is the number of consecutive failures
+// after which the circuit breaker of a node opens
+const defaultNodeCircuitBreakerFailures = 5
+
+// errNodeServerError marks 5xx responses as failures for the node circuit breaker
+var errNodeServerError = errors.New("node responded with server error")
+
 const (
 	HEALTHY   = true
 	UNHEALTHY = false
@@ -43,6 +70,14 @@ func NewAPICall(client circuit.HTTPRequestDoer, config *ClientConfig) *APICall {
 		client:               client,
 		numRetriesPerRequest: config.NumRetries,
 		retryInterval:        config.RetryInterval,
+		warningHandler:       config.WarningHandler,
+		maxResponseBytes:     config.MaxResponseBytes,
+	}
+	if len(config.DisableRetryFor) != 0 {
+		apiCall.noRetryOperations = make(map[string]bool, len(config.DisableRetryFor))
+		for _, op := range config.DisableRetryFor {
+			apiCall.noRetryOperations[op] = true
+		}
 	}
 
 	// default numRetries is the number of nodes (+1 if nearestNode is specified)
@@ -59,6 +94,47 @@ func NewAPICall(client circuit.HTTPRequestDoer, config *ClientConfig) *APICall {
 }
 
 func (a *APICall) Do(req *http.Request) (*http.Response, error) {
+	response, err := a.do(req)
+	if response != nil && a.warningHandler != nil {
+		for _, warning := range response.Header.Values(warningHeader) {
+			a.warningHandler(warning)
+		}
+	}
+	if response != nil && a.maxResponseBytes > 0 {
+		response.Body = &limitedResponseBody{
+			LimitedReader: io.LimitedReader{R: response.Body, N: a.maxResponseBytes + 1},
+			closer:        response.Body,
+			limit:         a.maxResponseBytes,
+		}
+	}
+	return response, err
+}
+
+// ErrResponseTooLarge is returned when reading a response body larger than
+// the limit set with WithMaxResponseBytes
+var ErrResponseTooLarge = errors.New("response body too large")
+
+// limitedResponseBody fails reading a response body past its limit
+type limitedResponseBody struct {
+	io.LimitedReader
+	closer io.Closer
+	limit  int64
+}
+
+func (b *limitedResponseBody) Read(p []byte) (int, error) {
+	n, err := b.LimitedReader.Read(p)
+	// the reader allows one byte past the limit to detect that it was exceeded
+	if b.N <= 0 {
+		return n, fmt.Errorf("%w: exceeds the limit of %d bytes", ErrResponseTooLarge, b.limit)
+	}
+	return n, err
+}
+
+func (b *limitedResponseBody) Close() error {
+	return b.closer.Close()
+}
+
+func (a *APICall) do(req *http.Request) (*http.Response, error) {
 	// Default is to not load balance for backward compatibility
 	if len(a.nodes) == 0 {
 		res, err := a.client.Do(req)
@@ -68,30 +144,43 @@ func (a *APICall) Do(req *http.Request) (*http.Response, error) {
 	var lastResponse *http.Response
 	var lastError error
 
-	for numTries := 0; numTries < a.numRetriesPerRequest; numTries++ {
+	maxTries := a.numRetriesPerRequest
+	if a.noRetryOperations[requestOperation(req)] {
+		maxTries = 1
+	}
+	for numTries := 0; numTries < maxTries; numTries++ {
 		node := a.getNextNode()
 
 		replaceRequestHostname(req, node.url)
 
-		response, err := a.client.Do(req)
+		response, err := a.doWithNode(node, req)
 
 		// return early if request is aborted
 		if errors.Is(err, context.Canceled) {
 			return nil, err
 		}
 
+		// If the circuit of the node is open, fail fast and try the next node. The node
+		// is marked unhealthy so that it is skipped until its next health check.
+		if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+			lastResponse = nil
+			lastError = err
+			a.setNodeHealthCheck(node, UNHEALTHY)
+			continue
+		}
+
 		// If connection timeouts or status 5xx, retry with the next node
 		if err != nil || response.StatusCode >= 500 {
 			lastResponse = response
 			lastError = err
 
-			setNodeHealthCheck(node, UNHEALTHY)
+			a.setNodeHealthCheck(node, UNHEALTHY)
 			time.Sleep(a.retryInterval)
 			continue
 		} else if response.StatusCode >= 1 && response.StatusCode <= 499 {
 			// Treat any status code > 0 and < 500 to be an indication that node is healthy
 			// We exclude 0 since some clients return 0 when request fails
-			setNodeHealthCheck(node, HEALTHY)
+			a.setNodeHealthCheck(node, HEALTHY)
 			return response, err
 		}
 	}
@@ -99,7 +188,79 @@ func (a *APICall) Do(req *http.Request) (*http.Response, error) {
 	return lastResponse, lastError
 }
 
+// Operations that can be named in WithDisableRetryFor
+const (
+	OperationImport           = "import"
+	OperationExport           = "export"
+	OperationSearch           = "search"
+	OperationMultiSearch      = "multi_search"
+	OperationIndexDocument    = "index_document"
+	OperationUpdateDocuments  = "update_documents"
+	OperationDeleteDocuments  = "delete_documents"
+	OperationRetrieveDocument = "retrieve_document"
+	OperationUpdateDocument   = "update_document"
+	OperationDeleteDocument   = "delete_document"
+)
+
+// requestOperation returns the operation of the request, or an empty string for
+// operations that can not be named in WithDisableRetryFor. The path is matched
+// from its end so that a base path is ignored.
+func requestOperation(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.EscapedPath(), "/"), "/")
+	n := len(segments)
+	switch {
+	case segments[n-1] == "multi_search" && req.Method == http.MethodPost:
+		return OperationMultiSearch
+	case n >= 3 && segments[n-3] == "collections" && segments[n-1] == "documents":
+		switch req.Method {
+		case http.MethodPost:
+			return OperationIndexDocument
+		case http.MethodPatch:
+			return OperationUpdateDocuments
+		case http.MethodDelete:
+			return OperationDeleteDocuments
+		}
+	case n >= 4 && segments[n-4] == "collections" && segments[n-2] == "documents":
+		switch {
+		case segments[n-1] == "import" && req.Method == http.MethodPost:
+			return OperationImport
+		case segments[n-1] == "export" && req.Method == http.MethodGet:
+			return OperationExport
+		case segments[n-1] == "search" && req.Method == http.MethodGet:
+			return OperationSearch
+		case req.Method == http.MethodGet:
+			return OperationRetrieveDocument
+		case req.Method == http.MethodPatch:
+			return OperationUpdateDocument
+		case req.Method == http.MethodDelete:
+			return OperationDeleteDocument
+		}
+	}
+	return ""
+}
+
+// doWithNode sends the request to the node through its circuit breaker, if any
+func (a *APICall) doWithNode(node *Node, req *http.Request) (response *http.Response, err error) {
+	if node.breaker == nil {
+		return a.client.Do(req)
+	}
+	err = node.breaker.Execute(func() (err error) {
+		response, err = a.client.Do(req)
+		if err == nil && response.StatusCode >= 500 {
+			return errNodeServerError
+		}
+		return err
+	})
+	if errors.Is(err, errNodeServerError) {
+		return response, nil
+	}
+	return response, err
+}
+
 func (a *APICall) getNextNode() *Node {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
 	if a.nearestNode != nil && (a.nearestNode.isHealthy || a.nodeDueForHealthcheck(a.nearestNode)) {
 		return a.nearestNode
 	}
@@ -120,14 +281,34 @@ func (a *APICall) getNextNode() *Node {
 func (a *APICall) initializeNodesMetadata(config *ClientConfig) {
 	if config.NearestNode != "" {
 		a.nearestNode = &Node{index: "nearestNode", url: config.NearestNode}
+		a.nearestNode.breaker = newNodeBreaker(config, config.NearestNode)
 		setNodeHealthCheck(a.nearestNode, HEALTHY)
 	}
 	a.nodes = make([]Node, 0, len(config.Nodes))
 	for i, v := range config.Nodes {
-		a.nodes = append(a.nodes, Node{isHealthy: true, index: i, url: v, lastAccessTimestamp: apiCallTimeNow().UnixMilli()})
+		a.nodes = append(a.nodes, Node{
+			isHealthy:           true,
+			index:               i,
+			url:                 v,
+			lastAccessTimestamp: apiCallTimeNow().UnixMilli(),
+			breaker:             newNodeBreaker(config, v),
+		})
 	}
 }
 
+// newNodeBreaker creates the circuit breaker of a node if node circuit breakers are enabled
+func newNodeBreaker(config *ClientConfig, nodeURL string) circuit.Breaker {
+	if config.NodeCircuitBreakerOptions == nil {
+		return nil
+	}
+	opts := append([]circuit.GoBreakerOption{
+		circuit.WithGoBreakerName(nodeURL),
+		circuit.WithGoBreakerMaxRequests(1),
+		circuit.WithGoBreakerReadyToTrip(circuit.ConsecutiveFailures(defaultNodeCircuitBreakerFailures)),
+	}, config.NodeCircuitBreakerOptions...)
+	return circuit.NewGoBreaker(opts...)
+}
+
 func replaceRequestHostname(req *http.Request, urlToReplace string) {
 	newURL, _ := url.Parse(urlToReplace)
 
@@ -136,6 +317,12 @@ func replaceRequestHostname(req *http.Request, urlToReplace string) {
 	req.Host = newURL.Host
 }
 
+func (a *APICall) setNodeHealthCheck(node *Node, isHealthy bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	setNodeHealthCheck(node, isHealthy)
+}
+
 func setNodeHealthCheck(node *Node, isHealthy bool) {
 	node.isHealthy = isHealthy
 	node.lastAccessTimestamp = apiCallTimeNow().UnixMilli()
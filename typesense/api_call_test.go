@@ -4,10 +4,13 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/sony/gobreaker"
 	"github.com/stretchr/testify/assert"
+	"github.com/typesense/typesense-go/v2/typesense/api/circuit"
 )
 
 type serverHandler func(http.ResponseWriter, *http.Request)
@@ -392,3 +395,294 @@ func TestApiCallCanAbortRequest(t *testing.T) {
 	assert.Nil(t, res)
 	assert.Equal(t, requestURLHistory, serverURLs[:3])
 }
+
+func TestApiCallReportsServerWarnings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Warning", `299 - "Parameter use_cache is deprecated"`)
+		w.Header().Add("Warning", `299 - "Parameter q is too long"`)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var warnings []string
+	apiCall := newAPICall(&ClientConfig{
+		Nodes: []string{server.URL},
+		WarningHandler: func(warning string) {
+			warnings = append(warnings, warning)
+		},
+	})
+
+	res, err := apiCall.Do(newHTTPRequest(t))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, []string{
+		`299 - "Parameter use_cache is deprecated"`,
+		`299 - "Parameter q is too long"`,
+	}, warnings)
+}
+
+func TestApiCallWithoutWarningsDoesNotCallHandler(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	apiCall := newAPICall(&ClientConfig{
+		ServerURL: server.URL,
+		WarningHandler: func(warning string) {
+			t.Errorf("unexpected warning: %s", warning)
+		},
+	})
+
+	_, err := apiCall.Do(newHTTPRequest(t, server.URL))
+	assert.NoError(t, err)
+}
+
+// The breakers use the real clock: breakerTestTimeout keeps a tripped circuit open
+// while the next requests are sent, and sleeping breakerTestMargin more moves it to
+// half-open, both with room to spare on a loaded machine.
+const (
+	breakerTestTimeout = 500 * time.Millisecond
+	breakerTestMargin  = 500 * time.Millisecond
+)
+
+func nodeBreakerState(t *testing.T, node *Node) gobreaker.State {
+	t.Helper()
+	breaker, ok := node.breaker.(*circuit.GoBreaker)
+	assert.True(t, ok)
+	return breaker.State()
+}
+
+func TestApiCallNodeCircuitBreakerTransitions(t *testing.T) {
+	failing := int32(1)
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if atomic.LoadInt32(&failing) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	apiCall := newAPICall(&ClientConfig{
+		Nodes:      []string{server.URL},
+		NumRetries: 1,
+		NodeCircuitBreakerOptions: []circuit.GoBreakerOption{
+			circuit.WithGoBreakerReadyToTrip(circuit.ConsecutiveFailures(2)),
+			circuit.WithGoBreakerTimeout(breakerTestTimeout),
+		},
+	})
+	node := &apiCall.nodes[0]
+
+	// closed: failures are passed through until the threshold is reached
+	res, err := apiCall.Do(newHTTPRequest(t))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	assert.Equal(t, gobreaker.StateClosed, nodeBreakerState(t, node))
+
+	res, err = apiCall.Do(newHTTPRequest(t))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	assert.Equal(t, gobreaker.StateOpen, nodeBreakerState(t, node))
+
+	// open: requests fail fast without reaching the node
+	_, err = apiCall.Do(newHTTPRequest(t))
+	assert.ErrorIs(t, err, gobreaker.ErrOpenState)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	// half-open after the timeout: a successful probe closes the circuit
+	time.Sleep(breakerTestTimeout + breakerTestMargin)
+	assert.Equal(t, gobreaker.StateHalfOpen, nodeBreakerState(t, node))
+	atomic.StoreInt32(&failing, 0)
+	res, err = apiCall.Do(newHTTPRequest(t))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, gobreaker.StateClosed, nodeBreakerState(t, node))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
+
+func TestApiCallNodeCircuitBreakerHalfOpenFailureReopens(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	apiCall := newAPICall(&ClientConfig{
+		Nodes:      []string{server.URL},
+		NumRetries: 1,
+		NodeCircuitBreakerOptions: []circuit.GoBreakerOption{
+			circuit.WithGoBreakerReadyToTrip(circuit.ConsecutiveFailures(1)),
+			circuit.WithGoBreakerTimeout(breakerTestTimeout),
+		},
+	})
+	node := &apiCall.nodes[0]
+
+	_, err := apiCall.Do(newHTTPRequest(t))
+	assert.NoError(t, err)
+	assert.Equal(t, gobreaker.StateOpen, nodeBreakerState(t, node))
+
+	time.Sleep(breakerTestTimeout + breakerTestMargin)
+	assert.Equal(t, gobreaker.StateHalfOpen, nodeBreakerState(t, node))
+	_, err = apiCall.Do(newHTTPRequest(t))
+	assert.NoError(t, err)
+	assert.Equal(t, gobreaker.StateOpen, nodeBreakerState(t, node))
+}
+
+func TestApiCallNodeCircuitBreakerOnlyTripsFailingNode(t *testing.T) {
+	var healthyRequests int32
+	servers, serverURLs := instantiateServers([]serverHandler{
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		},
+		func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&healthyRequests, 1)
+			w.WriteHeader(http.StatusOK)
+		},
+	})
+	for _, server := range servers {
+		defer server.Close()
+	}
+
+	apiCall := newAPICall(&ClientConfig{
+		Nodes: serverURLs,
+		NodeCircuitBreakerOptions: []circuit.GoBreakerOption{
+			circuit.WithGoBreakerReadyToTrip(circuit.ConsecutiveFailures(1)),
+		},
+	})
+
+	for i := 0; i < 4; i++ {
+		res, err := apiCall.Do(newHTTPRequest(t))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	}
+	assert.Equal(t, gobreaker.StateOpen, nodeBreakerState(t, &apiCall.nodes[0]))
+	assert.Equal(t, gobreaker.StateClosed, nodeBreakerState(t, &apiCall.nodes[1]))
+	assert.Equal(t, int32(4), atomic.LoadInt32(&healthyRequests))
+}
+
+func TestApiCallNodeCircuitBreakerOpenNearestNodeFallsBackToHealthyNode(t *testing.T) {
+	var nearestRequests, healthyRequests int32
+	servers, serverURLs := instantiateServers([]serverHandler{
+		func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&nearestRequests, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		},
+		func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&healthyRequests, 1)
+			w.WriteHeader(http.StatusOK)
+		},
+	})
+	for _, server := range servers {
+		defer server.Close()
+	}
+
+	freezeUnixMilli(0)
+	defer func() {
+		apiCallTimeNow = time.Now
+	}()
+
+	apiCall := newAPICall(&ClientConfig{
+		NearestNode:         serverURLs[0],
+		Nodes:               serverURLs[1:],
+		HealthcheckInterval: time.Minute,
+		NodeCircuitBreakerOptions: []circuit.GoBreakerOption{
+			circuit.WithGoBreakerReadyToTrip(circuit.ConsecutiveFailures(1)),
+			circuit.WithGoBreakerTimeout(time.Hour),
+		},
+	})
+
+	res, err := apiCall.Do(newHTTPRequest(t))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, gobreaker.StateOpen, nodeBreakerState(t, apiCall.nearestNode))
+
+	// the nearest node is due for a health check, but its circuit is still open
+	freezeUnixMilli(2 * time.Minute.Milliseconds())
+	for i := 0; i < 2; i++ {
+		res, err = apiCall.Do(newHTTPRequest(t))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&nearestRequests))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&healthyRequests))
+}
+
+func TestApiCallWithoutCircuitBreakerHasNoNodeBreakers(t *testing.T) {
+	apiCall := newAPICall(&ClientConfig{Nodes: []string{"http://localhost:8108"}, NearestNode: "http://localhost:8109"})
+	assert.Nil(t, apiCall.nodes[0].breaker)
+	assert.Nil(t, apiCall.nearestNode.breaker)
+}
+
+func TestApiCallDisableRetryForOperations(t *testing.T) {
+	var requests []string
+	servers, serverURLs := instantiateServers([]serverHandler{
+		func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		},
+		func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		},
+	})
+	for _, server := range servers {
+		defer server.Close()
+	}
+
+	apiCall := newAPICall(&ClientConfig{
+		Nodes:               serverURLs,
+		ConnectionTimeout:   5 * time.Second,
+		HealthcheckInterval: time.Minute,
+		DisableRetryFor:     []string{OperationImport},
+	})
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com/collections/companies/documents/import", nil)
+	assert.NoError(t, err)
+	res, err := apiCall.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	assert.Equal(t, []string{"POST /collections/companies/documents/import"}, requests)
+
+	requests = nil
+	req, err = http.NewRequest(http.MethodGet, "http://example.com/collections/companies/documents/search", nil)
+	assert.NoError(t, err)
+	res, err = apiCall.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	assert.Equal(t, []string{
+		"GET /collections/companies/documents/search",
+		"GET /collections/companies/documents/search",
+	}, requests)
+}
+
+func TestRequestOperation(t *testing.T) {
+	tests := []struct {
+		method   string
+		url      string
+		expected string
+	}{
+		{http.MethodPost, "http://example.com/collections/companies/documents/import?action=upsert", OperationImport},
+		{http.MethodGet, "http://example.com/collections/companies/documents/export", OperationExport},
+		{http.MethodGet, "http://example.com/collections/companies/documents/search?q=stark", OperationSearch},
+		{http.MethodPost, "http://example.com/multi_search", OperationMultiSearch},
+		{http.MethodPost, "http://example.com/collections/companies/documents", OperationIndexDocument},
+		{http.MethodPatch, "http://example.com/collections/companies/documents?filter_by=id:1", OperationUpdateDocuments},
+		{http.MethodDelete, "http://example.com/collections/companies/documents?filter_by=id:1", OperationDeleteDocuments},
+		{http.MethodGet, "http://example.com/collections/companies/documents/a%2Fb", OperationRetrieveDocument},
+		{http.MethodPatch, "http://example.com/collections/companies/documents/123", OperationUpdateDocument},
+		{http.MethodDelete, "http://example.com/collections/companies/documents/123", OperationDeleteDocument},
+		{http.MethodPost, "http://example.com/typesense/collections/companies/documents/import", OperationImport},
+		{http.MethodGet, "http://example.com/collections/companies", ""},
+		{http.MethodGet, "http://example.com/health", ""},
+		{http.MethodGet, "http://example.com/", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.url, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, tt.url, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, requestOperation(req))
+		})
+	}
+}
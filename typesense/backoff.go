@@ -0,0 +1,46 @@
+package typesense
+
+import (
+	"time"
+)
+
+// BackoffStrategy computes how long to wait between the attempts of a repeated operation.
+type BackoffStrategy interface {
+	// Backoff returns the delay after the given attempt, counting from 0
+	Backoff(attempt int) time.Duration
+}
+
+// ConstantBackoff waits the same delay after every attempt.
+type ConstantBackoff time.Duration
+
+func (b ConstantBackoff) Backoff(int) time.Duration {
+	return time.Duration(b)
+}
+
+// ExponentialBackoff multiplies the delay after each attempt, starting at Initial
+// and capped at Max.
+type ExponentialBackoff struct {
+	Initial time.Duration
+	// Max is the maximum delay, no maximum when zero
+	Max time.Duration
+	// Multiplier defaults to 2 when not set
+	Multiplier float64
+}
+
+func (b ExponentialBackoff) Backoff(attempt int) time.Duration {
+	multiplier := b.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+	delay := float64(b.Initial)
+	for i := 0; i < attempt; i++ {
+		delay *= multiplier
+		if b.Max > 0 && delay >= float64(b.Max) {
+			return b.Max
+		}
+	}
+	if b.Max > 0 && delay > float64(b.Max) {
+		return b.Max
+	}
+	return time.Duration(delay)
+}
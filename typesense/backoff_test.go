@@ -0,0 +1,38 @@
+package typesense
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstantBackoff(t *testing.T) {
+	backoff := ConstantBackoff(time.Second)
+	assert.Equal(t, time.Second, backoff.Backoff(0))
+	assert.Equal(t, time.Second, backoff.Backoff(10))
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff{Initial: 100 * time.Millisecond, Max: 5 * time.Second}
+	var delays []time.Duration
+	for attempt := 0; attempt < 8; attempt++ {
+		delays = append(delays, backoff.Backoff(attempt))
+	}
+	assert.Equal(t, []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		1600 * time.Millisecond,
+		3200 * time.Millisecond,
+		5 * time.Second,
+		5 * time.Second,
+	}, delays)
+}
+
+func TestExponentialBackoffWithMultiplier(t *testing.T) {
+	backoff := ExponentialBackoff{Initial: time.Second, Multiplier: 3}
+	assert.Equal(t, time.Second, backoff.Backoff(0))
+	assert.Equal(t, 9*time.Second, backoff.Backoff(2))
+}
@@ -3,12 +3,20 @@
 package typesense
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/typesense/typesense-go/v2/typesense/api"
 	"github.com/typesense/typesense-go/v2/typesense/api/circuit"
+	"golang.org/x/net/http2"
 )
 
 type APIClientInterface interface {
@@ -16,12 +24,18 @@ type APIClientInterface interface {
 	api.ClientInterface
 }
 
+// Client is safe for concurrent use by multiple goroutines and should be
+// created once and reused.
 type Client struct {
 	apiConfig   *ClientConfig
 	apiClient   APIClientInterface
 	collections CollectionsInterface
 	aliases     AliasesInterface
+	schemaCache *schemaCache
 	MultiSearch MultiSearchInterface
+
+	// customAPIClient is set when apiClient was passed with WithAPIClient
+	customAPIClient bool
 }
 
 func (c *Client) Collections() CollectionsInterface {
@@ -29,7 +43,8 @@ func (c *Client) Collections() CollectionsInterface {
 }
 
 func GenericCollection[T any](c *Client, collectionName string) CollectionInterface[T] {
-	return &collection[T]{apiClient: c.apiClient, name: collectionName}
+	return &collection[T]{apiClient: c.apiClient, name: collectionName, schemaCache: c.schemaCache,
+		structTag: c.apiConfig.StructTag, marshalDocuments: c.apiConfig.MarshalDocuments}
 }
 
 func (c *Client) Collection(collectionName string) CollectionInterface[map[string]any] {
@@ -89,11 +104,40 @@ func (e *HTTPError) Error() string {
 	return fmt.Sprintf("status: %v response: %s", e.Status, string(e.Body))
 }
 
+// Details returns the fields of the JSON error body, e.g. the message and any
+// additional context sent by the server, or nil if the body is not a JSON object.
+func (e *HTTPError) Details() map[string]interface{} {
+	var details map[string]interface{}
+	if err := json.Unmarshal(e.Body, &details); err != nil {
+		return nil
+	}
+	return details
+}
+
+// Message returns the message of the JSON error body, or an empty string if the
+// body has no message.
+func (e *HTTPError) Message() string {
+	message, _ := e.Details()["message"].(string)
+	return message
+}
+
+// ErrRequestEntityTooLarge matches, with errors.Is, the HTTPError of a request
+// rejected with status 413 because its body exceeds the server limit
+var ErrRequestEntityTooLarge = errors.New("request entity too large")
+
+// Is reports whether the error matches target, mapping status codes to sentinel errors
+func (e *HTTPError) Is(target error) bool {
+	return target == ErrRequestEntityTooLarge && e.Status == http.StatusRequestEntityTooLarge
+}
+
 const (
 	defaultRetryInterval       = 100 * time.Millisecond
 	defaultHealthcheckInterval = 1 * time.Minute
 	defaultConnectionTimeout   = 5 * time.Second
+	defaultKeepAlive           = 30 * time.Second
 	defaultCircuitBreakerName  = "typesenseClient"
+	defaultUserAgent           = "typesense-go/" + Version
+	defaultUnixSocketServerURL = "http://localhost"
 )
 
 type ClientConfig struct {
@@ -105,12 +149,30 @@ type ClientConfig struct {
 	HealthcheckInterval         time.Duration
 	APIKey                      string
 	ConnectionTimeout           time.Duration
+	Timeout                     time.Duration
+	DialTimeout                 time.Duration
+	ResponseHeaderTimeout       time.Duration
 	CircuitBreakerName          string
 	CircuitBreakerMaxRequests   uint32
 	CircuitBreakerInterval      time.Duration
 	CircuitBreakerTimeout       time.Duration
 	CircuitBreakerReadyToTrip   circuit.GoBreakerReadyToTripFunc
 	CircuitBreakerOnStateChange circuit.GoBreakerOnStateChangeFunc
+	UserAgent                   string
+	DefaultHeaders              map[string]string
+	BasePath                    string
+	SchemaCacheTTL              time.Duration
+	WarningHandler              WarningHandlerFunc
+	NodeCircuitBreakerOptions   []circuit.GoBreakerOption
+	MaxResponseBytes            int64
+	HTTP2                       bool
+	HTTP2PriorKnowledge         bool
+	UnixSocket                  string
+	InsecureSkipVerify          bool
+	CACert                      []byte
+	StructTag                   string
+	DisableRetryFor             []string
+	MarshalDocuments            bool
 }
 
 type ClientOption func(*Client)
@@ -176,6 +238,8 @@ func WithAPIKey(apiKey string) ClientOption {
 }
 
 // WithConnectionTimeout sets the connection timeout of http client.
+// It limits the total time of a request, use WithDialTimeout and
+// WithResponseHeaderTimeout to limit the individual phases of a request.
 // Default value is 5 seconds.
 func WithConnectionTimeout(timeout time.Duration) ClientOption {
 	return func(c *Client) {
@@ -183,6 +247,30 @@ func WithConnectionTimeout(timeout time.Duration) ClientOption {
 	}
 }
 
+// WithTimeout sets the overall time limit of a request, including connecting,
+// redirects and reading the response body. It takes precedence over WithConnectionTimeout.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.apiConfig.Timeout = timeout
+	}
+}
+
+// WithDialTimeout sets the maximum amount of time to wait for a connection
+// to a node to be established.
+func WithDialTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.apiConfig.DialTimeout = timeout
+	}
+}
+
+// WithResponseHeaderTimeout sets the maximum amount of time to wait for the
+// response headers after the request, including its body, has been written.
+func WithResponseHeaderTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.apiConfig.ResponseHeaderTimeout = timeout
+	}
+}
+
 // WithCircuitBreakerName sets the name of the CircuitBreaker.
 // Default value is "typesenseClient".
 func WithCircuitBreakerName(name string) ClientOption {
@@ -240,6 +328,155 @@ func WithCircuitBreakerOnStateChange(onStateChange circuit.GoBreakerOnStateChang
 	}
 }
 
+// WithCircuitBreaker enables a circuit breaker per node when load balancing across
+// multiple nodes (WithNodes and WithNearestNode). The circuit of a node opens after
+// 5 consecutive failures (errors or 5xx responses), requests to the node then fail
+// fast and are retried on the next node. After the CircuitBreaker timeout the circuit
+// becomes half-open and lets a single request through to probe the node, closing the
+// circuit again on success. The defaults can be changed with the given options, e.g.
+//
+//	WithCircuitBreaker(
+//		circuit.WithGoBreakerReadyToTrip(circuit.ConsecutiveFailures(3)),
+//		circuit.WithGoBreakerTimeout(30*time.Second),
+//	)
+//
+// The circuit breakers are independent of the client-wide CircuitBreaker configured
+// with the WithCircuitBreaker* options.
+func WithCircuitBreaker(opts ...circuit.GoBreakerOption) ClientOption {
+	return func(c *Client) {
+		c.apiConfig.NodeCircuitBreakerOptions = append([]circuit.GoBreakerOption{}, opts...)
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+// Default value is "typesense-go/<Version>".
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.apiConfig.UserAgent = userAgent
+	}
+}
+
+// WithDefaultHeaders sets custom headers sent with every request, e.g. headers
+// required by an API gateway in front of Typesense. The headers are sent in addition
+// to the API key header, which they cannot override.
+func WithDefaultHeaders(headers map[string]string) ClientOption {
+	return func(c *Client) {
+		c.apiConfig.DefaultHeaders = headers
+	}
+}
+
+// WithBasePath sets the path prefix under which the Typesense API is mounted,
+// e.g. when the server is running behind a reverse proxy at "/search".
+// Leading and trailing slashes are normalized.
+func WithBasePath(basePath string) ClientOption {
+	return func(c *Client) {
+		c.apiConfig.BasePath = basePath
+	}
+}
+
+// WithSchemaCache enables caching of the schemas retrieved with Collection(name).Retrieve()
+// for the given time to live. Updating or deleting a collection through the client
+// invalidates its cached schema. The cache is disabled by default.
+func WithSchemaCache(ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.apiConfig.SchemaCacheTTL = ttl
+	}
+}
+
+// WithWarningHandler sets the function that is called with each non-fatal warning
+// sent by the server in the Warning response header, e.g. about the use of a
+// deprecated parameter, and with a server version mismatch found by
+// CheckServerCompatibility.
+func WithWarningHandler(handler WarningHandlerFunc) ClientOption {
+	return func(c *Client) {
+		c.apiConfig.WarningHandler = handler
+	}
+}
+
+// WithMaxResponseBytes limits the size of the response bodies read by the client.
+// Reading a larger response body fails with ErrResponseTooLarge. There is no limit by default.
+func WithMaxResponseBytes(n int64) ClientOption {
+	return func(c *Client) {
+		c.apiConfig.MaxResponseBytes = n
+	}
+}
+
+// WithHTTP2 makes the client use HTTP/2. With priorKnowledge the client talks HTTP/2
+// over plaintext connections without upgrade negotiation (h2c), which requires the
+// server to support it and http:// server URLs. Without priorKnowledge HTTP/2 is
+// negotiated on TLS connections. By default the standard transport negotiation is used.
+func WithHTTP2(priorKnowledge bool) ClientOption {
+	return func(c *Client) {
+		c.apiConfig.HTTP2 = true
+		c.apiConfig.HTTP2PriorKnowledge = priorKnowledge
+	}
+}
+
+// WithUnixSocket makes the client connect to the server over the unix domain socket
+// at path instead of TCP. Requests are still sent over HTTP; the host of the server
+// URL is only used for the Host header and defaults to localhost if no server is set.
+func WithUnixSocket(path string) ClientOption {
+	return func(c *Client) {
+		c.apiConfig.UnixSocket = path
+	}
+}
+
+// WithInsecureSkipVerify disables the verification of the server certificate chain
+// and host name on TLS connections.
+//
+// WARNING: this makes the connection vulnerable to man-in-the-middle attacks and
+// must only be used for local development, e.g. against a server with a self-signed
+// certificate. Never use it in production.
+func WithInsecureSkipVerify() ClientOption {
+	return func(c *Client) {
+		c.apiConfig.InsecureSkipVerify = true
+	}
+}
+
+// WithCACert makes the client verify the server certificate against the PEM encoded
+// CA certificates in pemBytes instead of the system roots, e.g. for a server with a
+// certificate signed by an internal CA. If pemBytes contains no valid certificate,
+// every request fails with ErrInvalidCACert.
+func WithCACert(pemBytes []byte) ClientOption {
+	return func(c *Client) {
+		c.apiConfig.CACert = pemBytes
+	}
+}
+
+// WithStructTag sets the struct tag that maps the document fields to the fields of
+// the struct types used as typed documents, e.g. with GenericCollection and
+// SearchTyped. Struct fields without the tag are mapped by their name. By default
+// the json tags are used.
+//
+// The tag only applies to decoding documents returned by the server. Documents
+// sent to the server, e.g. with Create, Upsert and Import, are still encoded with
+// their json tags. Values held in maps and interface fields are decoded with their
+// json tags as well.
+func WithStructTag(tag string) ClientOption {
+	return func(c *Client) {
+		c.apiConfig.StructTag = tag
+	}
+}
+
+// WithDisableRetryFor disables the retries of the given operations, e.g.
+// OperationImport, which are then sent to a single node even if it fails.
+// Other operations are still retried. Retries only happen when nodes are set.
+func WithDisableRetryFor(ops ...string) ClientOption {
+	return func(c *Client) {
+		c.apiConfig.DisableRetryFor = ops
+	}
+}
+
+// WithMarshalDocuments encodes struct documents with api.MarshalDocument when they
+// are indexed or imported, e.g. time.Time fields are sent as unix timestamps instead
+// of RFC3339 strings. By default only documents implementing api.DocumentMarshaler
+// are encoded with it and other structs are sent with their encoding/json encoding.
+func WithMarshalDocuments() ClientOption {
+	return func(c *Client) {
+		c.apiConfig.MarshalDocuments = true
+	}
+}
+
 // WithClientConfig allows to pass all configs at once
 func WithClientConfig(config *ClientConfig) ClientOption {
 	return func(c *Client) {
@@ -251,12 +488,30 @@ func WithClientConfig(config *ClientConfig) ClientOption {
 		c.apiConfig.HealthcheckInterval = config.HealthcheckInterval
 		c.apiConfig.APIKey = config.APIKey
 		c.apiConfig.ConnectionTimeout = config.ConnectionTimeout
+		c.apiConfig.Timeout = config.Timeout
+		c.apiConfig.DialTimeout = config.DialTimeout
+		c.apiConfig.ResponseHeaderTimeout = config.ResponseHeaderTimeout
 		c.apiConfig.CircuitBreakerName = config.CircuitBreakerName
 		c.apiConfig.CircuitBreakerMaxRequests = config.CircuitBreakerMaxRequests
 		c.apiConfig.CircuitBreakerInterval = config.CircuitBreakerInterval
 		c.apiConfig.CircuitBreakerTimeout = config.CircuitBreakerTimeout
 		c.apiConfig.CircuitBreakerReadyToTrip = config.CircuitBreakerReadyToTrip
 		c.apiConfig.CircuitBreakerOnStateChange = config.CircuitBreakerOnStateChange
+		c.apiConfig.UserAgent = config.UserAgent
+		c.apiConfig.DefaultHeaders = config.DefaultHeaders
+		c.apiConfig.BasePath = config.BasePath
+		c.apiConfig.SchemaCacheTTL = config.SchemaCacheTTL
+		c.apiConfig.WarningHandler = config.WarningHandler
+		c.apiConfig.NodeCircuitBreakerOptions = config.NodeCircuitBreakerOptions
+		c.apiConfig.MaxResponseBytes = config.MaxResponseBytes
+		c.apiConfig.HTTP2 = config.HTTP2
+		c.apiConfig.HTTP2PriorKnowledge = config.HTTP2PriorKnowledge
+		c.apiConfig.UnixSocket = config.UnixSocket
+		c.apiConfig.InsecureSkipVerify = config.InsecureSkipVerify
+		c.apiConfig.CACert = config.CACert
+		c.apiConfig.StructTag = config.StructTag
+		c.apiConfig.DisableRetryFor = config.DisableRetryFor
+		c.apiConfig.MarshalDocuments = config.MarshalDocuments
 	}
 }
 
@@ -270,11 +525,40 @@ func NewClient(opts ...ClientOption) *Client {
 		CircuitBreakerInterval:    circuit.DefaultGoBreakerInterval,
 		CircuitBreakerTimeout:     circuit.DefaultGoBreakerTimeout,
 		CircuitBreakerReadyToTrip: circuit.DefaultReadyToTrip,
+		UserAgent:                 defaultUserAgent,
 	}}
+	return newClient(c, opts)
+}
+
+// Clone returns a new client with a copy of the config of c, to which opts are
+// applied. c is not modified. The clone has its own connections, circuit breaker
+// and schema cache; a client passed with WithAPIClient is shared with the clone.
+func (c *Client) Clone(opts ...ClientOption) *Client {
+	config := *c.apiConfig
+	config.Nodes = append([]string(nil), c.apiConfig.Nodes...)
+	config.NodeCircuitBreakerOptions = append([]circuit.GoBreakerOption(nil), c.apiConfig.NodeCircuitBreakerOptions...)
+	config.CACert = append([]byte(nil), c.apiConfig.CACert...)
+	config.DisableRetryFor = append([]string(nil), c.apiConfig.DisableRetryFor...)
+	if c.apiConfig.DefaultHeaders != nil {
+		config.DefaultHeaders = make(map[string]string, len(c.apiConfig.DefaultHeaders))
+		for name, value := range c.apiConfig.DefaultHeaders {
+			config.DefaultHeaders[name] = value
+		}
+	}
+	clone := &Client{apiConfig: &config}
+	if c.customAPIClient {
+		clone.apiClient = c.apiClient
+	}
+	return newClient(clone, opts)
+}
+
+// newClient applies opts to c and creates its API client and services
+func newClient(c *Client, opts []ClientOption) *Client {
 	// implement option pattern
 	for _, opt := range opts {
 		opt(c)
 	}
+	c.customAPIClient = c.apiClient != nil
 	if c.apiClient == nil {
 		cb := circuit.NewGoBreaker(
 			circuit.WithGoBreakerName(c.apiConfig.CircuitBreakerName),
@@ -287,9 +571,7 @@ func NewClient(opts ...ClientOption) *Client {
 		httpClient := circuit.NewHTTPClient(
 			circuit.WithHTTPRequestDoer(
 				NewAPICall(
-					&http.Client{
-						Timeout: c.apiConfig.ConnectionTimeout,
-					},
+					newHTTPClient(c.apiConfig),
 					c.apiConfig,
 				)),
 			circuit.WithCircuitBreaker(cb),
@@ -304,16 +586,120 @@ func NewClient(opts ...ClientOption) *Client {
 		default:
 			if len(c.apiConfig.Nodes) != 0 {
 				serverURL = c.apiConfig.Nodes[0]
+			} else if c.apiConfig.UnixSocket != "" {
+				serverURL = defaultUnixSocketServerURL
 			}
 		}
 
+		serverURL = joinBasePath(serverURL, c.apiConfig.BasePath)
+
+		userAgent := c.apiConfig.UserAgent
+		if userAgent == "" {
+			userAgent = defaultUserAgent
+		}
+
 		apiClient, _ := api.NewClientWithResponses(serverURL,
+			api.WithDefaultHeaders(c.apiConfig.DefaultHeaders),
 			api.WithAPIKey(c.apiConfig.APIKey),
+			api.WithUserAgent(userAgent),
 			api.WithHTTPClient(httpClient))
 		c.apiClient = apiClient
 	}
-	c.collections = &collections{c.apiClient}
+	if c.apiConfig.SchemaCacheTTL > 0 {
+		c.schemaCache = newSchemaCache(c.apiConfig.SchemaCacheTTL)
+	}
+	c.collections = &collections{apiClient: c.apiClient, schemaCache: c.schemaCache}
 	c.aliases = &aliases{c.apiClient}
 	c.MultiSearch = &multiSearch{c.apiClient}
 	return c
 }
+
+// newHTTPClient creates the http client with the timeouts of the config
+func newHTTPClient(config *ClientConfig) *http.Client {
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = config.ConnectionTimeout
+	}
+	httpClient := &http.Client{
+		Timeout: timeout,
+	}
+	dialer := &net.Dialer{
+		Timeout:   config.DialTimeout,
+		KeepAlive: defaultKeepAlive,
+	}
+	dialContext := dialer.DialContext
+	if config.UnixSocket != "" {
+		// every connection goes to the socket, whatever the address of the node
+		dialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "unix", config.UnixSocket)
+		}
+	}
+	switch {
+	case config.HTTP2 && config.HTTP2PriorKnowledge:
+		httpClient.Transport = &http2.Transport{
+			AllowHTTP: true,
+			// h2c connections are plaintext, the TLS config is not used
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				return dialContext(ctx, network, addr)
+			},
+		}
+	case config.HTTP2 || config.DialTimeout != 0 || config.ResponseHeaderTimeout != 0 || config.UnixSocket != "" ||
+		config.InsecureSkipVerify || len(config.CACert) != 0:
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		if config.DialTimeout != 0 || config.UnixSocket != "" {
+			transport.DialContext = dialContext
+		}
+		tlsConfig, err := newTLSConfig(config)
+		if err != nil {
+			// NewClient does not return errors, every request fails with err instead
+			httpClient.Transport = failingTransport{err: err}
+			return httpClient
+		}
+		transport.TLSClientConfig = tlsConfig
+		transport.ResponseHeaderTimeout = config.ResponseHeaderTimeout
+		if config.HTTP2 {
+			transport.ForceAttemptHTTP2 = true
+		}
+		httpClient.Transport = transport
+	}
+	return httpClient
+}
+
+// ErrInvalidCACert is returned by the requests of a client created with WithCACert
+// given no valid PEM encoded certificate
+var ErrInvalidCACert = errors.New("invalid CA certificate")
+
+// newTLSConfig creates the TLS config of the transport, nil for the default config
+func newTLSConfig(config *ClientConfig) (*tls.Config, error) {
+	if !config.InsecureSkipVerify && len(config.CACert) == 0 {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{InsecureSkipVerify: config.InsecureSkipVerify} //nolint:gosec // explicitly requested
+	if len(config.CACert) != 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(config.CACert) {
+			return nil, fmt.Errorf("%w: no PEM encoded certificate found", ErrInvalidCACert)
+		}
+	}
+	return tlsConfig, nil
+}
+
+// failingTransport fails every request with err
+type failingTransport struct {
+	err error
+}
+
+func (t failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	return nil, t.err
+}
+
+func joinBasePath(serverURL string, basePath string) string {
+	basePath = strings.Trim(basePath, "/")
+	if basePath == "" {
+		return serverURL
+	}
+	return strings.TrimSuffix(serverURL, "/") + "/" + basePath
+}
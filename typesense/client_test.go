@@ -1,7 +1,18 @@
 package typesense
 
 import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -9,6 +20,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/typesense/typesense-go/v2/typesense/api"
 	"github.com/typesense/typesense-go/v2/typesense/api/circuit"
+	"github.com/typesense/typesense-go/v2/typesense/api/pointer"
+	"github.com/typesense/typesense-go/v2/typesense/mocks"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 )
 
 func TestHttpError(t *testing.T) {
@@ -16,6 +32,50 @@ func TestHttpError(t *testing.T) {
 	assert.Equal(t, "status: 200 response: error message body", err.Error())
 }
 
+func TestHttpErrorIsRequestEntityTooLarge(t *testing.T) {
+	var err error = &HTTPError{Status: http.StatusRequestEntityTooLarge, Body: []byte("Request Entity Too Large")}
+	assert.ErrorIs(t, err, ErrRequestEntityTooLarge)
+	assert.ErrorIs(t, fmt.Errorf("import failed: %w", err), ErrRequestEntityTooLarge)
+	assert.NotErrorIs(t, &HTTPError{Status: http.StatusBadRequest}, ErrRequestEntityTooLarge)
+}
+
+func TestHttpErrorDetails(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{
+			"message": "Field ` + "`num_employees`" + ` must be an int32.",
+			"code": 400,
+			"field": "num_employees",
+			"document": {"id": "124"}
+		}`))
+	})
+	defer server.Close()
+
+	_, err := client.Collection("companies").Documents().Create(context.Background(), map[string]any{"id": "124"})
+	var httpErr *HTTPError
+	if assert.ErrorAs(t, err, &httpErr) {
+		assert.Equal(t, http.StatusBadRequest, httpErr.Status)
+		assert.Equal(t, "Field `num_employees` must be an int32.", httpErr.Message())
+		assert.Equal(t, map[string]interface{}{
+			"message":  "Field `num_employees` must be an int32.",
+			"code":     float64(400),
+			"field":    "num_employees",
+			"document": map[string]interface{}{"id": "124"},
+		}, httpErr.Details())
+	}
+}
+
+func TestHttpErrorDetailsWithNonJSONBody(t *testing.T) {
+	err := &HTTPError{Status: http.StatusBadGateway, Body: []byte("Bad Gateway")}
+	assert.Nil(t, err.Details())
+	assert.Equal(t, "", err.Message())
+
+	err = &HTTPError{Status: http.StatusNotFound, Body: []byte(`{"message": 404}`)}
+	assert.Equal(t, map[string]interface{}{"message": float64(404)}, err.Details())
+	assert.Equal(t, "", err.Message())
+}
+
 func getAPIClient(t *testing.T, apiClient APIClientInterface) *api.Client {
 	t.Helper()
 	assert.NotNil(t, apiClient)
@@ -48,6 +108,7 @@ func TestClientConfigOptions(t *testing.T) {
 				assert.Equal(t, circuit.DefaultGoBreakerMaxRequests, client.apiConfig.CircuitBreakerMaxRequests)
 				assert.Equal(t, circuit.DefaultGoBreakerInterval, client.apiConfig.CircuitBreakerInterval)
 				assert.Equal(t, circuit.DefaultGoBreakerTimeout, client.apiConfig.CircuitBreakerTimeout)
+				assert.Equal(t, "typesense-go/"+Version, client.apiConfig.UserAgent)
 				assert.Equal(t,
 					reflect.ValueOf(circuit.DefaultReadyToTrip).Pointer(),
 					reflect.ValueOf(client.apiConfig.CircuitBreakerReadyToTrip).Pointer(),
@@ -204,6 +265,60 @@ func TestClientConfigOptions(t *testing.T) {
 				assert.NotNil(t, client.apiClient)
 			},
 		},
+		{
+			name: "WithUserAgent",
+			options: []ClientOption{
+				WithUserAgent("my-service/1.0"),
+			},
+			verify: func(t *testing.T, client *Client) {
+				assert.Equal(t, "my-service/1.0", client.apiConfig.UserAgent)
+				assert.NotNil(t, client.apiClient)
+			},
+		},
+		{
+			name: "WithBasePath",
+			options: []ClientOption{
+				WithServer("http://example.com"),
+				WithBasePath("/search/"),
+			},
+			verify: func(t *testing.T, client *Client) {
+				assert.Equal(t, "/search/", client.apiConfig.BasePath)
+				apiClient := getAPIClient(t, client.apiClient)
+				assert.Equal(t, "http://example.com/search/", apiClient.Server)
+			},
+		},
+		{
+			name: "WithTimeouts",
+			options: []ClientOption{
+				WithTimeout(time.Minute),
+				WithDialTimeout(2 * time.Second),
+				WithResponseHeaderTimeout(10 * time.Second),
+			},
+			verify: func(t *testing.T, client *Client) {
+				assert.Equal(t, time.Minute, client.apiConfig.Timeout)
+				assert.Equal(t, 2*time.Second, client.apiConfig.DialTimeout)
+				assert.Equal(t, 10*time.Second, client.apiConfig.ResponseHeaderTimeout)
+			},
+		},
+		{
+			name: "WithCircuitBreaker",
+			options: []ClientOption{
+				WithCircuitBreaker(circuit.WithGoBreakerTimeout(30 * time.Second)),
+			},
+			verify: func(t *testing.T, client *Client) {
+				assert.Len(t, client.apiConfig.NodeCircuitBreakerOptions, 1)
+			},
+		},
+		{
+			name: "WithCircuitBreakerDefaults",
+			options: []ClientOption{
+				WithCircuitBreaker(),
+			},
+			verify: func(t *testing.T, client *Client) {
+				assert.NotNil(t, client.apiConfig.NodeCircuitBreakerOptions)
+				assert.Empty(t, client.apiConfig.NodeCircuitBreakerOptions)
+			},
+		},
 		{
 			name: "WithConfig",
 			options: []ClientOption{
@@ -246,3 +361,435 @@ func TestClientConfigOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestClientSendsUserAgentHeader(t *testing.T) {
+	tests := []struct {
+		name              string
+		options           []ClientOption
+		expectedUserAgent string
+	}{
+		{
+			name:              "default",
+			expectedUserAgent: "typesense-go/" + Version,
+		},
+		{
+			name:              "custom",
+			options:           []ClientOption{WithUserAgent("my-service/1.0")},
+			expectedUserAgent: "my-service/1.0",
+		},
+		{
+			name:              "empty falls back to default",
+			options:           []ClientOption{WithUserAgent("")},
+			expectedUserAgent: "typesense-go/" + Version,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.expectedUserAgent, r.Header.Get("User-Agent"))
+				assert.Equal(t, "API_KEY", r.Header.Get(api.APIKeyHeader))
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"ok": true}`))
+			}))
+			defer server.Close()
+
+			options := append([]ClientOption{WithServer(server.URL), WithAPIKey("API_KEY")}, tt.options...)
+			client := NewClient(options...)
+			ok, err := client.Health(context.Background(), 2*time.Second)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+		})
+	}
+}
+
+func TestClientSendsDefaultHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "tenant-1", r.Header.Get("X-Tenant-Id"))
+		assert.Equal(t, "gateway", r.Header.Get("X-Source"))
+		assert.Equal(t, []string{"API_KEY"}, r.Header.Values(api.APIKeyHeader))
+		assert.Equal(t, "typesense-go/"+Version, r.Header.Get("User-Agent"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithServer(server.URL),
+		WithAPIKey("API_KEY"),
+		WithDefaultHeaders(map[string]string{
+			"X-Tenant-Id":         "tenant-1",
+			"x-source":            "gateway",
+			"x-typesense-api-key": "OTHER_KEY",
+		}))
+	ok, err := client.Health(context.Background(), 2*time.Second)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestNewHTTPClientTimeouts(t *testing.T) {
+	t.Run("connection timeout only", func(t *testing.T) {
+		httpClient := newHTTPClient(&ClientConfig{ConnectionTimeout: 5 * time.Second})
+		assert.Equal(t, 5*time.Second, httpClient.Timeout)
+		assert.Nil(t, httpClient.Transport)
+	})
+
+	t.Run("timeout takes precedence over connection timeout", func(t *testing.T) {
+		httpClient := newHTTPClient(&ClientConfig{
+			ConnectionTimeout: 5 * time.Second,
+			Timeout:           time.Minute,
+		})
+		assert.Equal(t, time.Minute, httpClient.Timeout)
+		assert.Nil(t, httpClient.Transport)
+	})
+
+	t.Run("dial and response header timeouts", func(t *testing.T) {
+		httpClient := newHTTPClient(&ClientConfig{
+			Timeout:               time.Minute,
+			DialTimeout:           2 * time.Second,
+			ResponseHeaderTimeout: 10 * time.Second,
+		})
+		assert.Equal(t, time.Minute, httpClient.Timeout)
+		transport, ok := httpClient.Transport.(*http.Transport)
+		assert.True(t, ok)
+		assert.NotNil(t, transport.DialContext)
+		assert.Equal(t, 10*time.Second, transport.ResponseHeaderTimeout)
+		assert.Equal(t, http.DefaultTransport.(*http.Transport).MaxIdleConns, transport.MaxIdleConns)
+	})
+}
+
+func TestNewHTTPClientHTTP2(t *testing.T) {
+	t.Run("default transport negotiation", func(t *testing.T) {
+		httpClient := newHTTPClient(&ClientConfig{ConnectionTimeout: 5 * time.Second})
+		assert.Nil(t, httpClient.Transport)
+	})
+
+	t.Run("http2 over tls", func(t *testing.T) {
+		httpClient := newHTTPClient(&ClientConfig{ConnectionTimeout: 5 * time.Second, HTTP2: true})
+		transport, ok := httpClient.Transport.(*http.Transport)
+		assert.True(t, ok)
+		assert.True(t, transport.ForceAttemptHTTP2)
+	})
+
+	t.Run("h2c prior knowledge", func(t *testing.T) {
+		httpClient := newHTTPClient(&ClientConfig{
+			ConnectionTimeout:   5 * time.Second,
+			HTTP2:               true,
+			HTTP2PriorKnowledge: true,
+		})
+		assert.Equal(t, 5*time.Second, httpClient.Timeout)
+		transport, ok := httpClient.Transport.(*http2.Transport)
+		assert.True(t, ok)
+		assert.True(t, transport.AllowHTTP)
+		assert.NotNil(t, transport.DialTLSContext)
+	})
+}
+
+func TestClientWithHTTP2PriorKnowledge(t *testing.T) {
+	server := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, 2, r.ProtoMajor)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": true}`))
+	}), &http2.Server{}))
+	defer server.Close()
+
+	client := NewClient(WithServer(server.URL), WithHTTP2(true))
+	ok, err := client.Health(context.Background(), 2*time.Second)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestClientWithUnixSocket(t *testing.T) {
+	dir, err := os.MkdirTemp("", "typesense")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	socketPath := filepath.Join(dir, "typesense.sock")
+
+	listener, err := net.Listen("unix", socketPath)
+	assert.NoError(t, err)
+	hosts := make(chan string, 1)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r, "/health", http.MethodGet)
+		hosts <- r.Host
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": true}`))
+	}))
+	server.Listener.Close()
+	server.Listener = listener
+	server.Start()
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		opts         []ClientOption
+		expectedHost string
+	}{
+		{name: "default server", opts: []ClientOption{WithUnixSocket(socketPath)}, expectedHost: "localhost"},
+		{name: "with server", opts: []ClientOption{WithUnixSocket(socketPath), WithServer("http://typesense.internal:8108")}, expectedHost: "typesense.internal:8108"},
+		{name: "with http2", opts: []ClientOption{WithUnixSocket(socketPath), WithHTTP2(false)}, expectedHost: "localhost"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient(tt.opts...)
+			ok, err := client.Health(context.Background(), 2*time.Second)
+			if assert.NoError(t, err) {
+				assert.True(t, ok)
+				assert.Equal(t, tt.expectedHost, <-hosts)
+			}
+		})
+	}
+}
+
+func TestClientWithInsecureSkipVerify(t *testing.T) {
+	httpClient := newHTTPClient(&ClientConfig{InsecureSkipVerify: true})
+	transport, ok := httpClient.Transport.(*http.Transport)
+	if assert.True(t, ok) {
+		assert.True(t, transport.TLSClientConfig.InsecureSkipVerify)
+	}
+	assert.Nil(t, newHTTPClient(&ClientConfig{}).Transport)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+
+	_, err := NewClient(WithServer(server.URL)).Health(context.Background(), 2*time.Second)
+	assert.Error(t, err)
+
+	ok, err = NewClient(WithServer(server.URL), WithInsecureSkipVerify()).Health(context.Background(), 2*time.Second)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestClientWithCACert(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+	// the test server certificate is self-signed and acts as its own CA
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	httpClient := newHTTPClient(&ClientConfig{CACert: caCert})
+	transport, ok := httpClient.Transport.(*http.Transport)
+	if assert.True(t, ok) {
+		assert.False(t, transport.TLSClientConfig.InsecureSkipVerify)
+		assert.NotNil(t, transport.TLSClientConfig.RootCAs)
+	}
+
+	ok, err := NewClient(WithServer(server.URL), WithCACert(caCert)).Health(context.Background(), 2*time.Second)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = NewClient(WithServer(server.URL), WithCACert([]byte("not a certificate"))).Health(context.Background(), 2*time.Second)
+	assert.ErrorIs(t, err, ErrInvalidCACert)
+	assert.ErrorContains(t, err, "invalid CA certificate: no PEM encoded certificate found")
+}
+
+func TestClientClone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(jsonEncode(t, map[string]bool{"ok": r.Header.Get(api.APIKeyHeader) == "admin-key"}))
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithServer(server.URL),
+		WithAPIKey("admin-key"),
+		WithConnectionTimeout(3*time.Second),
+		WithDefaultHeaders(map[string]string{"X-Tenant": "acme"}))
+
+	clone := client.Clone(WithAPIKey("scoped-key"), WithConnectionTimeout(time.Second))
+	clone.apiConfig.DefaultHeaders["X-Tenant"] = "globex"
+
+	assert.Equal(t, "admin-key", client.apiConfig.APIKey)
+	assert.Equal(t, 3*time.Second, client.apiConfig.ConnectionTimeout)
+	assert.Equal(t, map[string]string{"X-Tenant": "acme"}, client.apiConfig.DefaultHeaders)
+	assert.Equal(t, "scoped-key", clone.apiConfig.APIKey)
+	assert.Equal(t, time.Second, clone.apiConfig.ConnectionTimeout)
+	assert.Equal(t, server.URL, clone.apiConfig.ServerURL)
+
+	ok, err := client.Health(context.Background(), 2*time.Second)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = clone.Health(context.Background(), 2*time.Second)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestClientCloneKeepsCustomAPIClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		HealthWithResponse(gomock.Not(gomock.Nil())).
+		Return(&api.HealthResponse{JSON200: &api.HealthStatus{Ok: true}}, nil).
+		Times(1)
+
+	clone := NewClient(WithAPIClient(mockAPIClient)).Clone(WithAPIKey("scoped-key"))
+	ok, err := clone.Health(context.Background(), 2*time.Second)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestJoinBasePath(t *testing.T) {
+	tests := []struct {
+		serverURL string
+		basePath  string
+		expected  string
+	}{
+		{serverURL: "http://example.com", basePath: "", expected: "http://example.com"},
+		{serverURL: "http://example.com", basePath: "/", expected: "http://example.com"},
+		{serverURL: "http://example.com", basePath: "search", expected: "http://example.com/search"},
+		{serverURL: "http://example.com", basePath: "/search", expected: "http://example.com/search"},
+		{serverURL: "http://example.com/", basePath: "/search/", expected: "http://example.com/search"},
+		{serverURL: "http://example.com", basePath: "/api/search/", expected: "http://example.com/api/search"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.serverURL+" "+tt.basePath, func(t *testing.T) {
+			assert.Equal(t, tt.expected, joinBasePath(tt.serverURL, tt.basePath))
+		})
+	}
+}
+
+func TestClientWithBasePathPrefixesRequestPath(t *testing.T) {
+	for _, multiNode := range []bool{false, true} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			validateRequestMetadata(t, r, "/search/health", http.MethodGet)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"ok": true}`))
+		}))
+
+		options := []ClientOption{WithServer(server.URL), WithBasePath("search/")}
+		if multiNode {
+			options = []ClientOption{WithNodes([]string{server.URL}), WithBasePath("/search")}
+		}
+		client := NewClient(options...)
+		ok, err := client.Health(context.Background(), 2*time.Second)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		server.Close()
+	}
+}
+
+func TestClientConcurrentSearches(t *testing.T) {
+	var requestCount int32
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		// fail every third request to exercise the node health bookkeeping
+		if atomic.AddInt32(&requestCount, 1)%3 == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"found": 1, "hits": []}`))
+	}
+	servers, serverURLs := instantiateServers([]serverHandler{handler, handler, handler})
+	for _, server := range servers {
+		defer server.Close()
+	}
+
+	client := NewClient(
+		WithNearestNode(serverURLs[0]),
+		WithNodes(serverURLs[1:]),
+		WithNumRetries(5),
+		WithRetryInterval(time.Millisecond),
+		WithHealthcheckInterval(time.Millisecond),
+	)
+
+	const numGoroutines = 50
+	var wg sync.WaitGroup
+	wg.Add(numGoroutines)
+	for i := 0; i < numGoroutines; i++ {
+		go func() {
+			defer wg.Done()
+			result, err := client.Collection("companies").Documents().Search(context.Background(),
+				&api.SearchCollectionParams{Q: pointer.String("*")})
+			assert.NoError(t, err)
+			assert.Equal(t, pointer.Int(1), result.Found)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestClientWithPerRequestAPIKeyOverride(t *testing.T) {
+	var receivedKeys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedKeys = append(receivedKeys, r.Header.Get(api.APIKeyHeader))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"found": 0, "hits": []}`))
+	}))
+	defer server.Close()
+	client := NewClient(WithServer(server.URL), WithAPIKey("CLIENT_KEY"))
+
+	params := &api.SearchCollectionParams{Q: pointer.String("*")}
+	scopedCtx := api.ContextWithAPIKey(context.Background(), "SCOPED_KEY")
+	_, err := client.Collection("companies").Documents().Search(scopedCtx, params)
+	assert.NoError(t, err)
+	_, err = client.Collection("companies").Documents().Search(context.Background(), params)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"SCOPED_KEY", "CLIENT_KEY"}, receivedKeys)
+}
+
+func TestClientWithWarningHandler(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Warning", `299 - "Parameter use_cache is deprecated"`)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"found": 0, "hits": []}`))
+	}))
+	defer server.Close()
+
+	var warnings []string
+	client := NewClient(WithServer(server.URL), WithWarningHandler(func(warning string) {
+		warnings = append(warnings, warning)
+	}))
+	_, err := client.Collection("companies").Documents().Search(context.Background(),
+		&api.SearchCollectionParams{Q: pointer.String("*")})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{`299 - "Parameter use_cache is deprecated"`}, warnings)
+}
+
+func TestClientWithMaxResponseBytes(t *testing.T) {
+	body := `{"found": 0, "hits": []}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/collections/companies/documents/123" {
+			w.Write([]byte(`{"id": "123", "company_name": "` + strings.Repeat("x", 100) + `"}`))
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	t.Run("within limit", func(t *testing.T) {
+		client := NewClient(WithServer(server.URL), WithMaxResponseBytes(int64(len(body))))
+		_, err := client.Collection("companies").Documents().Search(context.Background(),
+			&api.SearchCollectionParams{Q: pointer.String("*")})
+		assert.NoError(t, err)
+	})
+
+	t.Run("oversized search response", func(t *testing.T) {
+		client := NewClient(WithServer(server.URL), WithMaxResponseBytes(int64(len(body)-1)))
+		_, err := client.Collection("companies").Documents().Search(context.Background(),
+			&api.SearchCollectionParams{Q: pointer.String("*")})
+		assert.ErrorIs(t, err, ErrResponseTooLarge)
+		assert.ErrorContains(t, err, fmt.Sprintf("exceeds the limit of %d bytes", len(body)-1))
+	})
+
+	t.Run("oversized document response", func(t *testing.T) {
+		client := NewClient(WithServer(server.URL), WithMaxResponseBytes(64))
+		_, err := client.Collection("companies").Document("123").Retrieve(context.Background())
+		assert.ErrorIs(t, err, ErrResponseTooLarge)
+	})
+}
+
+func TestClientWithDisableRetryFor(t *testing.T) {
+	client := NewClient(WithNodes([]string{"http://node1:8108"}), WithDisableRetryFor(OperationImport, OperationMultiSearch))
+	assert.Equal(t, []string{OperationImport, OperationMultiSearch}, client.apiConfig.DisableRetryFor)
+
+	clone := client.Clone()
+	clone.apiConfig.DisableRetryFor[0] = OperationSearch
+	assert.Equal(t, []string{OperationImport, OperationMultiSearch}, client.apiConfig.DisableRetryFor)
+}
@@ -2,8 +2,12 @@ package typesense
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/typesense/typesense-go/v2/typesense/api"
+	"github.com/typesense/typesense-go/v2/typesense/api/pointer"
 )
 
 // CollectionInterface is a type for Collection API operations
@@ -17,28 +21,69 @@ type CollectionInterface[T any] interface {
 	Synonyms() SynonymsInterface
 	Synonym(synonymID string) SynonymInterface
 	Update(context.Context, *api.CollectionUpdateSchema) (*api.CollectionUpdateSchema, error)
+	// Truncate deletes all the documents of the collection, keeping its schema, and
+	// returns the number of deleted documents. Requires Typesense server v29 or later.
+	Truncate(ctx context.Context) (int, error)
+	// SchemaDiff compares the live schema of the collection with the desired one
+	SchemaDiff(ctx context.Context, desired *api.CollectionSchema) (*SchemaDiff, error)
 }
 
 var _ CollectionInterface[any] = (*collection[any])(nil)
 
+// ErrInvalidCollectionName is returned for a collection name that can not be
+// used in a request path
+var ErrInvalidCollectionName = errors.New("invalid collection name")
+
+// validateCollectionName rejects empty names and names containing a slash.
+// Other special characters are path-escaped by the api client.
+func validateCollectionName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: must not be empty", ErrInvalidCollectionName)
+	}
+	if strings.Contains(name, "/") {
+		return fmt.Errorf("%w: %q must not contain '/'", ErrInvalidCollectionName, name)
+	}
+	return nil
+}
+
 // collection is internal implementation of CollectionInterface
 type collection[T any] struct {
-	apiClient APIClientInterface
-	name      string
+	apiClient   APIClientInterface
+	name        string
+	schemaCache *schemaCache
+	structTag   string
+	// marshalDocuments encodes struct documents with api.MarshalDocument
+	marshalDocuments bool
 }
 
 func (c *collection[T]) Retrieve(ctx context.Context) (*api.CollectionResponse, error) {
-	response, err := c.apiClient.GetCollectionWithResponse(ctx, c.name)
+	return retrieveCollection(ctx, c.apiClient, c.schemaCache, c.name)
+}
+
+// retrieveCollection retrieves the collection, using the schema cache if enabled
+func retrieveCollection(ctx context.Context, apiClient APIClientInterface, cache *schemaCache, name string) (*api.CollectionResponse, error) {
+	if err := validateCollectionName(name); err != nil {
+		return nil, err
+	}
+	if schema, ok := cache.get(name); ok {
+		return schema, nil
+	}
+	response, err := apiClient.GetCollectionWithResponse(ctx, name)
 	if err != nil {
 		return nil, err
 	}
 	if response.JSON200 == nil {
 		return nil, &HTTPError{Status: response.StatusCode(), Body: response.Body}
 	}
+	cache.set(name, response.JSON200)
 	return response.JSON200, nil
 }
 
 func (c *collection[T]) Delete(ctx context.Context) (*api.CollectionResponse, error) {
+	if err := validateCollectionName(c.name); err != nil {
+		return nil, err
+	}
+	defer c.schemaCache.invalidate(c.name)
 	response, err := c.apiClient.DeleteCollectionWithResponse(ctx, c.name)
 	if err != nil {
 		return nil, err
@@ -50,11 +95,13 @@ func (c *collection[T]) Delete(ctx context.Context) (*api.CollectionResponse, er
 }
 
 func (c *collection[T]) Documents() DocumentsInterface {
-	return &documents{apiClient: c.apiClient, collectionName: c.name}
+	return &documents{apiClient: c.apiClient, collectionName: c.name, schemaCache: c.schemaCache,
+		marshalDocuments: c.marshalDocuments}
 }
 
 func (c *collection[T]) Document(documentID string) DocumentInterface[T] {
-	return &document[T]{apiClient: c.apiClient, collectionName: c.name, documentID: documentID}
+	return &document[T]{apiClient: c.apiClient, collectionName: c.name, documentID: documentID, structTag: c.structTag,
+		marshalDocuments: c.marshalDocuments}
 }
 
 func (c *collection[T]) Overrides() OverridesInterface {
@@ -74,6 +121,10 @@ func (c *collection[T]) Synonym(synonymID string) SynonymInterface {
 }
 
 func (c *collection[T]) Update(ctx context.Context, schema *api.CollectionUpdateSchema) (*api.CollectionUpdateSchema, error) {
+	if err := validateCollectionName(c.name); err != nil {
+		return nil, err
+	}
+	defer c.schemaCache.invalidate(c.name)
 	response, err := c.apiClient.UpdateCollectionWithResponse(ctx, c.name,
 		api.UpdateCollectionJSONRequestBody(*schema))
 	if err != nil {
@@ -84,3 +135,15 @@ func (c *collection[T]) Update(ctx context.Context, schema *api.CollectionUpdate
 	}
 	return response.JSON200, nil
 }
+
+func (c *collection[T]) Truncate(ctx context.Context) (int, error) {
+	return c.Documents().Delete(ctx, &api.DeleteDocumentsParams{Truncate: pointer.True()})
+}
+
+func (c *collection[T]) SchemaDiff(ctx context.Context, desired *api.CollectionSchema) (*SchemaDiff, error) {
+	live, err := c.Retrieve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return DiffSchema(live, desired)
+}
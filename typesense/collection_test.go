@@ -2,6 +2,7 @@ package typesense
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"testing"
@@ -215,3 +216,228 @@ func TestCollectionUpdateOnHttpStatusErrorCodeReturnsError(t *testing.T) {
 	_, err := client.Collection("non_existent").Update(context.Background(), updateSchema)
 	assert.Error(t, err)
 }
+
+func TestCollectionTruncate(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r, "/collections/companies/documents?truncate=true", http.MethodDelete)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"num_deleted": 125}`))
+	})
+	defer server.Close()
+
+	numDeleted, err := client.Collection("companies").Truncate(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 125, numDeleted)
+}
+
+func TestCollectionTruncateOnHttpStatusErrorCodeReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		DeleteDocumentsWithResponse(gomock.Not(gomock.Nil()), "companies",
+			&api.DeleteDocumentsParams{Truncate: pointer.True()}).
+		Return(&api.DeleteDocumentsResponse{
+			HTTPResponse: &http.Response{
+				StatusCode: 404,
+			},
+			Body: []byte("Not Found"),
+		}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Collection("companies").Truncate(context.Background())
+	assert.Equal(t, &HTTPError{Status: 404, Body: []byte("Not Found")}, err)
+}
+
+func TestCollectionWithInvalidNameReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Collection("").Retrieve(context.Background())
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+	assert.EqualError(t, err, "invalid collection name: must not be empty")
+
+	_, err = client.Collection("").Delete(context.Background())
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+
+	_, err = client.Collection("companies/archive").Update(context.Background(), updateExistingSchema())
+	assert.EqualError(t, err, `invalid collection name: "companies/archive" must not contain '/'`)
+
+	_, err = client.Collection("").Documents().Search(context.Background(), &api.SearchCollectionParams{Q: pointer.String("*")})
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+
+	_, err = client.Collection("").Documents().Create(context.Background(), map[string]any{"id": "123"})
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+
+	_, err = client.Collection("").Documents().Import(context.Background(), []any{map[string]any{"id": "123"}}, nil)
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+}
+
+func TestCollectionHandlesWithInvalidNameReturnError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	collection := client.Collection("companies/archive")
+
+	_, err := collection.Document("123").Retrieve(context.Background())
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+	_, err = collection.Document("123").Update(context.Background(), map[string]any{"country": "USA"})
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+	_, err = collection.Document("123").Emplace(context.Background(), map[string]any{"country": "USA"})
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+	_, err = collection.Document("123").Delete(context.Background())
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+
+	_, err = collection.Overrides().Retrieve(context.Background())
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+	_, err = collection.Overrides().Upsert(context.Background(), "customize-apple", &api.SearchOverrideSchema{})
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+	_, err = collection.Override("customize-apple").Retrieve(context.Background())
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+	_, err = collection.Override("customize-apple").Upsert(context.Background(), &api.SearchOverrideSchema{})
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+	_, err = collection.Override("customize-apple").Delete(context.Background())
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+
+	_, err = collection.Synonyms().Retrieve(context.Background())
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+	_, err = collection.Synonyms().Upsert(context.Background(), "coat-synonyms", &api.SearchSynonymSchema{})
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+	_, err = collection.Synonym("coat-synonyms").Retrieve(context.Background())
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+	_, err = collection.Synonym("coat-synonyms").Delete(context.Background())
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+}
+
+func TestCollectionEscapesNameInPath(t *testing.T) {
+	expectedURIs := []string{
+		"/collections/companies%202024",
+		"/collections/companies%202024/documents/search?q=%2A",
+		"/collections/caf%C3%A9%3Fv=1",
+	}
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r, expectedURIs[0], http.MethodGet)
+		expectedURIs = expectedURIs[1:]
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/collections/companies 2024/documents/search" {
+			w.Write([]byte(`{"found": 0, "hits": []}`))
+			return
+		}
+		w.Write(jsonEncode(t, createNewCollection(r.URL.Path[len("/collections/"):])))
+	})
+	defer server.Close()
+
+	collection, err := client.Collection("companies 2024").Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "companies 2024", collection.Name)
+
+	_, err = client.Collection("companies 2024").Documents().Search(context.Background(),
+		&api.SearchCollectionParams{Q: pointer.String("*")})
+	assert.NoError(t, err)
+
+	collection, err = client.Collection("café?v=1").Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "café?v=1", collection.Name)
+	assert.Empty(t, expectedURIs)
+}
+
+func TestCollectionResponseDeserialization(t *testing.T) {
+	inputJSON := `{
+		"name": "companies",
+		"num_documents": 1250000000,
+		"created_at": 1706011468,
+		"fields": [{"name": "company_name", "type": "string"}],
+		"default_sorting_field": ""
+	}`
+
+	response := &api.CollectionResponse{}
+	err := json.Unmarshal([]byte(inputJSON), response)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "companies", response.Name)
+	assert.Equal(t, pointer.Int64(1250000000), response.NumDocuments)
+	assert.Equal(t, pointer.Int64(1706011468), response.CreatedAt)
+
+	response = &api.CollectionResponse{}
+	err = json.Unmarshal([]byte(`{"name": "companies", "fields": []}`), response)
+	assert.NoError(t, err)
+	assert.Nil(t, response.NumDocuments)
+	assert.Nil(t, response.CreatedAt)
+}
+
+func TestCollectionRetrieveWithAutoDetectedFields(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r, "/collections/events", http.MethodGet)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"name": "events",
+			"num_documents": 2,
+			"created_at": 1700000000,
+			"enable_nested_fields": true,
+			"fields": [
+				{"name": ".*", "type": "auto", "facet": false, "index": true, "optional": true, "sort": false, "infix": false, "locale": ""},
+				{"name": ".*_facet", "type": "auto", "facet": true, "index": true, "optional": true},
+				{"name": "title", "type": "string", "facet": false, "index": true, "optional": true, "sort": false},
+				{"name": "attendees", "type": "int64", "facet": false, "index": true, "optional": true, "sort": true},
+				{"name": "tags", "type": "string[]", "facet": false, "index": true, "optional": true},
+				{"name": "venue", "type": "object", "facet": false, "index": true, "optional": true},
+				{"name": "venue.city", "type": "string", "facet": false, "index": true, "optional": true}
+			]
+		}`))
+	})
+	defer server.Close()
+
+	result, err := client.Collection("events").Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, pointer.Int64(2), result.NumDocuments)
+	assert.Equal(t, []api.Field{
+		{Name: ".*", Type: "auto", Facet: pointer.False(), Index: pointer.True(), Optional: pointer.True(),
+			Sort: pointer.False(), Infix: pointer.False(), Locale: pointer.String("")},
+		{Name: ".*_facet", Type: "auto", Facet: pointer.True(), Index: pointer.True(), Optional: pointer.True()},
+		{Name: "title", Type: "string", Facet: pointer.False(), Index: pointer.True(), Optional: pointer.True(), Sort: pointer.False()},
+		{Name: "attendees", Type: "int64", Facet: pointer.False(), Index: pointer.True(), Optional: pointer.True(), Sort: pointer.True()},
+		{Name: "tags", Type: "string[]", Facet: pointer.False(), Index: pointer.True(), Optional: pointer.True()},
+		{Name: "venue", Type: "object", Facet: pointer.False(), Index: pointer.True(), Optional: pointer.True()},
+		{Name: "venue.city", Type: "string", Facet: pointer.False(), Index: pointer.True(), Optional: pointer.True()},
+	}, result.Fields)
+
+	data, err := json.Marshal(result.Fields[0])
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name": ".*", "type": "auto", "facet": false, "index": true, "optional": true, "sort": false, "infix": false, "locale": ""}`, string(data))
+}
+
+func TestCollectionMetadataRoundTrip(t *testing.T) {
+	metadata := map[string]interface{}{
+		"owner": "search-team",
+		"ui": map[string]interface{}{
+			"columns":   []interface{}{"company_name", "country"},
+			"page_size": float64(25),
+		},
+	}
+	schema := &api.CollectionSchema{
+		Name:     "companies",
+		Fields:   []api.Field{{Name: "company_name", Type: "string"}},
+		Metadata: &metadata,
+	}
+
+	data, err := json.Marshal(schema)
+	assert.NoError(t, err)
+	decodedSchema := &api.CollectionSchema{}
+	assert.NoError(t, json.Unmarshal(data, decodedSchema))
+	assert.Equal(t, schema, decodedSchema)
+
+	response := &api.CollectionResponse{}
+	assert.NoError(t, json.Unmarshal(data, response))
+	assert.Equal(t, &metadata, response.Metadata)
+
+	data, err = json.Marshal(&api.CollectionSchema{Name: "companies", Fields: []api.Field{}})
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "metadata")
+}
@@ -2,6 +2,11 @@ package typesense
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/typesense/typesense-go/v2/typesense/api"
 )
@@ -10,14 +15,49 @@ import (
 type CollectionsInterface interface {
 	Create(ctx context.Context, schema *api.CollectionSchema) (*api.CollectionResponse, error)
 	Retrieve(ctx context.Context) ([]*api.CollectionResponse, error)
+	// Counts returns the number of documents of each of the named collections,
+	// retrieving the collections concurrently. Collections that fail to be
+	// retrieved are left out of the result and reported in a CollectionErrors.
+	Counts(ctx context.Context, names []string) (map[string]int64, error)
+}
+
+// collectionsPageSize is the number of collections fetched per request by Retrieve
+const collectionsPageSize = 250
+
+// collectionsCountsConcurrency is the maximum number of collections retrieved at once by Counts
+const collectionsCountsConcurrency = 8
+
+// CollectionErrors holds the errors of an operation on several collections by collection name
+type CollectionErrors map[string]error
+
+func (e CollectionErrors) Error() string {
+	names := make([]string, 0, len(e))
+	for name := range e {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	messages := make([]string, len(names))
+	for i, name := range names {
+		messages[i] = fmt.Sprintf("collection %s: %v", name, e[name])
+	}
+	return strings.Join(messages, "; ")
 }
 
 // collections is internal implementation of CollectionsInterface
 type collections struct {
-	apiClient APIClientInterface
+	apiClient   APIClientInterface
+	schemaCache *schemaCache
 }
 
 func (c *collections) Create(ctx context.Context, schema *api.CollectionSchema) (*api.CollectionResponse, error) {
+	if err := validateCollectionName(schema.Name); err != nil {
+		return nil, err
+	}
+	if err := schema.Validate(); err != nil {
+		return nil, err
+	}
+	// a schema cached for a dropped collection of the same name is outdated
+	defer c.schemaCache.invalidate(schema.Name)
 	response, err := c.apiClient.CreateCollectionWithResponse(ctx,
 		api.CreateCollectionJSONRequestBody(*schema))
 	if err != nil {
@@ -29,8 +69,28 @@ func (c *collections) Create(ctx context.Context, schema *api.CollectionSchema)
 	return response.JSON201, nil
 }
 
+// Retrieve returns all collections, fetching them page by page if the server paginates
 func (c *collections) Retrieve(ctx context.Context) ([]*api.CollectionResponse, error) {
-	response, err := c.apiClient.GetCollectionsWithResponse(ctx)
+	result := []*api.CollectionResponse{}
+	for offset := 0; ; offset += collectionsPageSize {
+		page, err := c.retrievePage(ctx, collectionsPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+		// a short page ends the list, as does a longer one from a server ignoring the limit
+		if len(page) != collectionsPageSize {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (c *collections) retrievePage(ctx context.Context, limit int, offset int) ([]*api.CollectionResponse, error) {
+	response, err := c.apiClient.GetCollectionsWithResponse(ctx, withRawQueryParams(map[string]string{
+		"limit":  strconv.Itoa(limit),
+		"offset": strconv.Itoa(offset),
+	}))
 	if err != nil {
 		return nil, err
 	}
@@ -39,3 +99,36 @@ func (c *collections) Retrieve(ctx context.Context) ([]*api.CollectionResponse,
 	}
 	return *response.JSON200, nil
 }
+
+func (c *collections) Counts(ctx context.Context, names []string) (map[string]int64, error) {
+	counts := make(map[string]int64, len(names))
+	errs := CollectionErrors{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, collectionsCountsConcurrency)
+	for _, name := range names {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			collection, err := retrieveCollection(ctx, c.apiClient, nil, name)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[name] = err
+				return
+			}
+			var count int64
+			if collection.NumDocuments != nil {
+				count = *collection.NumDocuments
+			}
+			counts[name] = count
+		}(name)
+	}
+	wg.Wait()
+	if len(errs) != 0 {
+		return counts, errs
+	}
+	return counts, nil
+}
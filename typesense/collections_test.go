@@ -2,9 +2,15 @@ package typesense
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/jinzhu/copier"
 	"github.com/stretchr/testify/assert"
@@ -130,7 +136,7 @@ func TestCollectionsRetrieve(t *testing.T) {
 	assert.Nil(t, copier.Copy(&mockedResult, &expectedResult))
 
 	mockAPIClient.EXPECT().
-		GetCollectionsWithResponse(gomock.Not(gomock.Nil())).
+		GetCollectionsWithResponse(gomock.Not(gomock.Nil()), gomock.Any()).
 		Return(&api.GetCollectionsResponse{
 			JSON200: &mockedResult,
 		}, nil).
@@ -143,13 +149,106 @@ func TestCollectionsRetrieve(t *testing.T) {
 	assert.Equal(t, expectedResult, result)
 }
 
+func createCollectionsPage(offset int, size int) []*api.CollectionResponse {
+	page := make([]*api.CollectionResponse, size)
+	for i := range page {
+		page[i] = createNewCollection(fmt.Sprintf("collection_%d", offset+i))
+	}
+	return page
+}
+
+func TestCollectionsRetrieveFetchesAllPages(t *testing.T) {
+	allCollections := createCollectionsPage(0, 2*collectionsPageSize+3)
+	var offsets []string
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/collections", r.URL.Path)
+		assert.Equal(t, strconv.Itoa(collectionsPageSize), r.URL.Query().Get("limit"))
+		offset, err := strconv.Atoi(r.URL.Query().Get("offset"))
+		assert.NoError(t, err)
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+		end := offset + collectionsPageSize
+		if end > len(allCollections) {
+			end = len(allCollections)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(jsonEncode(t, allCollections[offset:end]))
+	})
+	defer server.Close()
+
+	result, err := client.Collections().Retrieve(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, allCollections, result)
+	assert.Equal(t, []string{"0", "250", "500"}, offsets)
+}
+
+func TestCollectionsRetrieveWithExactMultipleOfPageSize(t *testing.T) {
+	allCollections := createCollectionsPage(0, collectionsPageSize)
+	requests := 0
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("offset") == "0" {
+			w.Write(jsonEncode(t, allCollections))
+			return
+		}
+		w.Write([]byte("[]"))
+	})
+	defer server.Close()
+
+	result, err := client.Collections().Retrieve(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, allCollections, result)
+	assert.Equal(t, 2, requests)
+}
+
+func TestCollectionsRetrieveWithoutServerPagination(t *testing.T) {
+	// the server ignores limit and offset and always returns all collections
+	allCollections := createCollectionsPage(0, collectionsPageSize+10)
+	requests := 0
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(jsonEncode(t, allCollections))
+	})
+	defer server.Close()
+
+	result, err := client.Collections().Retrieve(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, allCollections, result)
+	assert.Equal(t, 1, requests)
+}
+
+func TestCollectionsRetrieveOnSecondPageErrorReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	firstPage := createCollectionsPage(0, collectionsPageSize)
+	notNil := gomock.Not(gomock.Nil())
+	gomock.InOrder(
+		mockAPIClient.EXPECT().
+			GetCollectionsWithResponse(notNil, gomock.Any()).
+			Return(&api.GetCollectionsResponse{JSON200: &firstPage}, nil),
+		mockAPIClient.EXPECT().
+			GetCollectionsWithResponse(notNil, gomock.Any()).
+			Return(nil, errors.New("failed request")),
+	)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Collections().Retrieve(context.Background())
+	assert.Error(t, err)
+}
+
 func TestCollectionsRetrieveOnApiClientErrorReturnsError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
 
 	mockAPIClient.EXPECT().
-		GetCollectionsWithResponse(gomock.Not(gomock.Nil())).
+		GetCollectionsWithResponse(gomock.Not(gomock.Nil()), gomock.Any()).
 		Return(nil, errors.New("failed request")).
 		Times(1)
 
@@ -164,7 +263,7 @@ func TestCollectionsRetrieveOnHttpStatusErrorCodeReturnsError(t *testing.T) {
 	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
 
 	mockAPIClient.EXPECT().
-		GetCollectionsWithResponse(gomock.Not(gomock.Nil())).
+		GetCollectionsWithResponse(gomock.Not(gomock.Nil()), gomock.Any()).
 		Return(&api.GetCollectionsResponse{
 			HTTPResponse: &http.Response{
 				StatusCode: 500,
@@ -177,3 +276,352 @@ func TestCollectionsRetrieveOnHttpStatusErrorCodeReturnsError(t *testing.T) {
 	_, err := client.Collections().Retrieve(context.Background())
 	assert.Error(t, err)
 }
+
+func TestCollectionCreateWithVectorField(t *testing.T) {
+	newSchema := &api.CollectionSchema{
+		Name: "products",
+		Fields: []api.Field{
+			{
+				Name:    "embedding",
+				Type:    "float[]",
+				NumDim:  pointer.Int(256),
+				VecDist: pointer.String("ip"),
+			},
+		},
+	}
+
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r, "/collections", http.MethodPost)
+
+		var reqBody map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&reqBody)
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{
+				"name":     "embedding",
+				"type":     "float[]",
+				"num_dim":  float64(256),
+				"vec_dist": "ip",
+			},
+		}, reqBody["fields"])
+
+		reqBody["num_documents"] = 0
+		reqBody["created_at"] = 1700000000
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write(jsonEncode(t, reqBody))
+	})
+	defer server.Close()
+
+	result, err := client.Collections().Create(context.Background(), newSchema)
+	assert.NoError(t, err)
+	assert.Equal(t, newSchema.Fields, result.Fields)
+}
+
+func TestFieldHnswParamsJSONRoundTrip(t *testing.T) {
+	field := api.Field{
+		Name:   "embedding",
+		Type:   "float[]",
+		NumDim: pointer.Int(384),
+		HnswParams: &api.FieldHnswParams{
+			M:              pointer.Int(32),
+			EfConstruction: pointer.Int(400),
+		},
+	}
+
+	data, err := json.Marshal(field)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"name": "embedding",
+		"type": "float[]",
+		"num_dim": 384,
+		"hnsw_params": {"M": 32, "ef_construction": 400}
+	}`, string(data))
+
+	var decoded api.Field
+	err = json.Unmarshal(data, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, field, decoded)
+}
+
+func TestCollectionSchemaTokenizationOptionsJSONRoundTrip(t *testing.T) {
+	schema := api.CollectionSchema{
+		Name: "products",
+		Fields: []api.Field{
+			{Name: "sku", Type: "string"},
+		},
+		TokenSeparators: &[]string{"-", "/"},
+		SymbolsToIndex:  &[]string{"+", "#"},
+	}
+
+	data, err := json.Marshal(schema)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"name": "products",
+		"fields": [{"name": "sku", "type": "string"}],
+		"token_separators": ["-", "/"],
+		"symbols_to_index": ["+", "#"]
+	}`, string(data))
+
+	var decoded api.CollectionSchema
+	err = json.Unmarshal(data, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, schema, decoded)
+
+	var response api.CollectionResponse
+	err = json.Unmarshal(data, &response)
+	assert.NoError(t, err)
+	assert.Equal(t, schema.TokenSeparators, response.TokenSeparators)
+	assert.Equal(t, schema.SymbolsToIndex, response.SymbolsToIndex)
+}
+
+func TestCollectionSchemaCreateTimeOptionsJSONRoundTrip(t *testing.T) {
+	schema := api.CollectionSchema{
+		Name: "products",
+		Fields: []api.Field{
+			{Name: "sku", Type: "string"},
+		},
+		VoiceQueryModel: &api.VoiceQueryModelCollectionConfig{ModelName: pointer.String("ts/whisper/base.en")},
+		SynonymSets:     &[]string{"clothing", "colors"},
+	}
+
+	data, err := json.Marshal(schema)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"name": "products",
+		"fields": [{"name": "sku", "type": "string"}],
+		"voice_query_model": {"model_name": "ts/whisper/base.en"},
+		"synonym_sets": ["clothing", "colors"]
+	}`, string(data))
+
+	var decoded api.CollectionSchema
+	err = json.Unmarshal(data, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, schema, decoded)
+
+	var response api.CollectionResponse
+	err = json.Unmarshal(data, &response)
+	assert.NoError(t, err)
+	assert.Equal(t, schema.VoiceQueryModel, response.VoiceQueryModel)
+	assert.Equal(t, schema.SynonymSets, response.SynonymSets)
+}
+
+func TestFieldCreateTimeOptionsJSONRoundTrip(t *testing.T) {
+	fields := []api.Field{
+		{
+			Name:            "title",
+			Type:            "string",
+			Store:           pointer.False(),
+			Stem:            pointer.True(),
+			StemDictionary:  pointer.String("irregular-plurals"),
+			TokenSeparators: &[]string{"-"},
+			SymbolsToIndex:  &[]string{"+"},
+		},
+		{Name: "price", Type: "float", RangeIndex: pointer.True()},
+		{Name: "brand_id", Type: "string", Reference: pointer.String("brands.id"), AsyncReference: pointer.True()},
+	}
+
+	data, err := json.Marshal(fields)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{
+			"name": "title",
+			"type": "string",
+			"store": false,
+			"stem": true,
+			"stem_dictionary": "irregular-plurals",
+			"token_separators": ["-"],
+			"symbols_to_index": ["+"]
+		},
+		{"name": "price", "type": "float", "range_index": true},
+		{"name": "brand_id", "type": "string", "reference": "brands.id", "async_reference": true}
+	]`, string(data))
+
+	var decoded []api.Field
+	err = json.Unmarshal(data, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, fields, decoded)
+}
+
+func TestFieldEmbedModelConfigJSONRoundTrip(t *testing.T) {
+	data := `{
+		"name": "embedding",
+		"type": "float[]",
+		"embed": {
+			"from": ["title"],
+			"model_config": {
+				"model_name": "openai/text-embedding-3-small",
+				"url": "http://localhost:8000/v1",
+				"indexing_prefix": "passage:",
+				"query_prefix": "query:"
+			}
+		}
+	}`
+
+	var field api.Field
+	err := json.Unmarshal([]byte(data), &field)
+	assert.NoError(t, err)
+	if assert.NotNil(t, field.Embed) {
+		assert.Equal(t, pointer.String("http://localhost:8000/v1"), field.Embed.ModelConfig.Url)
+		assert.Equal(t, pointer.String("passage:"), field.Embed.ModelConfig.IndexingPrefix)
+		assert.Equal(t, pointer.String("query:"), field.Embed.ModelConfig.QueryPrefix)
+	}
+
+	encoded, err := json.Marshal(field)
+	assert.NoError(t, err)
+	assert.JSONEq(t, data, string(encoded))
+}
+
+func TestCollectionSchemaValidate(t *testing.T) {
+	tests := []struct {
+		name                string
+		fields              []api.Field
+		defaultSortingField *string
+		expectedErr         string
+	}{
+		{
+			name:   "without default sorting field",
+			fields: []api.Field{{Name: "company_name", Type: "string"}},
+		},
+		{
+			name:                "empty default sorting field",
+			fields:              []api.Field{{Name: "company_name", Type: "string"}},
+			defaultSortingField: pointer.String(""),
+		},
+		{
+			name:                "int32 field",
+			fields:              []api.Field{{Name: "num_employees", Type: "int32"}},
+			defaultSortingField: pointer.String("num_employees"),
+		},
+		{
+			name:                "int64 field",
+			fields:              []api.Field{{Name: "founded", Type: "int64", Sort: pointer.True()}},
+			defaultSortingField: pointer.String("founded"),
+		},
+		{
+			name:                "float field",
+			fields:              []api.Field{{Name: "rating", Type: "float"}},
+			defaultSortingField: pointer.String("rating"),
+		},
+		{
+			name:                "missing field",
+			fields:              []api.Field{{Name: "num_employees", Type: "int32"}},
+			defaultSortingField: pointer.String("rating"),
+			expectedErr:         `default_sorting_field "rating" is not a field of the schema`,
+		},
+		{
+			name:                "string field",
+			fields:              []api.Field{{Name: "company_name", Type: "string"}},
+			defaultSortingField: pointer.String("company_name"),
+			expectedErr:         `default_sorting_field "company_name" must be of type int32, int64 or float, got "string"`,
+		},
+		{
+			name:                "array field",
+			fields:              []api.Field{{Name: "ratings", Type: "float[]"}},
+			defaultSortingField: pointer.String("ratings"),
+			expectedErr:         `default_sorting_field "ratings" must be of type int32, int64 or float, got "float[]"`,
+		},
+		{
+			name:                "sort disabled",
+			fields:              []api.Field{{Name: "num_employees", Type: "int32", Sort: pointer.False()}},
+			defaultSortingField: pointer.String("num_employees"),
+			expectedErr:         `default_sorting_field "num_employees" must have sorting enabled`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := &api.CollectionSchema{Name: "companies", Fields: tt.fields, DefaultSortingField: tt.defaultSortingField}
+			err := schema.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestCollectionCreateWithInvalidSchemaReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	newSchema := createNewSchema("companies")
+	newSchema.DefaultSortingField = pointer.String("country")
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Collections().Create(context.Background(), newSchema)
+	assert.EqualError(t, err, `default_sorting_field "country" must be of type int32, int64 or float, got "string"`)
+}
+
+func TestCollectionCreateWithEmptyNameReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Collections().Create(context.Background(), createNewSchema(""))
+	assert.ErrorIs(t, err, ErrInvalidCollectionName)
+}
+
+func TestCollectionsCounts(t *testing.T) {
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		assert.Equal(t, http.MethodGet, r.Method)
+		name := strings.TrimPrefix(r.URL.Path, "/collections/")
+		if strings.HasPrefix(name, "missing") {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "Not Found"}`))
+			return
+		}
+		collection := createNewCollection(name)
+		collection.NumDocuments = pointer.Int64(int64(len(name)))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(jsonEncode(t, collection))
+	})
+	defer server.Close()
+
+	names := []string{"missing-books", "companies", "missing-cars"}
+	for i := 0; i < 2*collectionsCountsConcurrency; i++ {
+		names = append(names, "collection-"+strconv.Itoa(i))
+	}
+	counts, err := client.Collections().Counts(context.Background(), names)
+
+	var errs CollectionErrors
+	if assert.ErrorAs(t, err, &errs) {
+		notFound := &HTTPError{Status: http.StatusNotFound, Body: []byte(`{"message": "Not Found"}`)}
+		assert.Equal(t, CollectionErrors{"missing-books": notFound, "missing-cars": notFound}, errs)
+	}
+	assert.EqualError(t, err, `collection missing-books: status: 404 response: {"message": "Not Found"}; `+
+		`collection missing-cars: status: 404 response: {"message": "Not Found"}`)
+	assert.Len(t, counts, len(names)-2)
+	assert.Equal(t, int64(9), counts["companies"])
+	assert.Equal(t, int64(12), counts["collection-0"])
+	assert.LessOrEqual(t, maxInFlight, collectionsCountsConcurrency)
+}
+
+func TestCollectionsCountsWithoutErrors(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		collection := createNewCollection("companies")
+		collection.NumDocuments = nil
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(jsonEncode(t, collection))
+	})
+	defer server.Close()
+
+	counts, err := client.Collections().Counts(context.Background(), []string{"companies"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"companies": 0}, counts)
+}
@@ -0,0 +1,135 @@
+package typesense
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/typesense/typesense-go/v2/typesense/api"
+)
+
+// streamDone is the data of the server-sent event ending a stream
+const streamDone = "[DONE]"
+
+// maxConversationEventLine is the longest line of a server-sent event that is read,
+// large enough for the event holding the search result with all its hits
+const maxConversationEventLine = 64 << 20
+
+var sseDataField = []byte("data:")
+
+// ConversationChunk is a chunk of the answer streamed by a conversational search
+type ConversationChunk struct {
+	ConversationId string `json:"conversation_id"`
+	Message        string `json:"message"`
+}
+
+// ConversationStream iterates over the answer chunks of a streamed conversational
+// search. The stream is closed once all chunks are read; it must be closed explicitly
+// if the iteration is stopped early:
+//
+//	for stream.Next() {
+//		fmt.Print(stream.Chunk().Message)
+//	}
+//	if err := stream.Err(); err != nil { ... }
+type ConversationStream struct {
+	body    io.ReadCloser
+	scanner *bufio.Scanner
+	chunk   ConversationChunk
+	result  *api.SearchResult
+	err     error
+	done    bool
+}
+
+func newConversationStream(body io.ReadCloser) *ConversationStream {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxConversationEventLine)
+	return &ConversationStream{body: body, scanner: scanner}
+}
+
+// Next advances to the next answer chunk and reports whether there is one.
+// The stream is closed when the end of the answer or an error is reached.
+func (s *ConversationStream) Next() bool {
+	for !s.done {
+		data, ok := s.nextEvent()
+		if !ok {
+			break
+		}
+		if string(data) == streamDone {
+			break
+		}
+		var event map[string]json.RawMessage
+		if err := json.Unmarshal(data, &event); err != nil {
+			s.err = err
+			break
+		}
+		// the search result, e.g. the hits the answer is based on, is sent as an event too
+		if _, ok := event["message"]; !ok {
+			s.result = &api.SearchResult{}
+			if err := json.Unmarshal(data, s.result); err != nil {
+				s.err = err
+				break
+			}
+			continue
+		}
+		s.chunk = ConversationChunk{}
+		if err := json.Unmarshal(data, &s.chunk); err != nil {
+			s.err = err
+			break
+		}
+		return true
+	}
+	s.Close()
+	return false
+}
+
+// nextEvent returns the data of the next server-sent event, joining multi-line data
+func (s *ConversationStream) nextEvent() ([]byte, bool) {
+	var data [][]byte
+	for s.scanner.Scan() {
+		line := s.scanner.Bytes()
+		if len(line) == 0 {
+			if len(data) != 0 {
+				return bytes.Join(data, []byte("\n")), true
+			}
+			continue
+		}
+		if bytes.HasPrefix(line, sseDataField) {
+			value := bytes.TrimPrefix(line[len(sseDataField):], []byte(" "))
+			data = append(data, append([]byte(nil), value...))
+		}
+	}
+	if err := s.scanner.Err(); err != nil {
+		s.err = err
+		return nil, false
+	}
+	if len(data) != 0 {
+		return bytes.Join(data, []byte("\n")), true
+	}
+	return nil, false
+}
+
+// Chunk returns the current answer chunk
+func (s *ConversationStream) Chunk() ConversationChunk {
+	return s.chunk
+}
+
+// Result returns the search result sent along with the answer, once it has been read
+// from the stream
+func (s *ConversationStream) Result() *api.SearchResult {
+	return s.result
+}
+
+// Err returns the error that ended the iteration, if any
+func (s *ConversationStream) Err() error {
+	return s.err
+}
+
+// Close closes the underlying connection. It is safe to call Close several times.
+func (s *ConversationStream) Close() error {
+	if s.done {
+		return nil
+	}
+	s.done = true
+	return s.body.Close()
+}
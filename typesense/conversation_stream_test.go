@@ -0,0 +1,122 @@
+package typesense
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/typesense/typesense-go/v2/typesense/api"
+	"github.com/typesense/typesense-go/v2/typesense/api/pointer"
+)
+
+type closeRecordingReader struct {
+	io.Reader
+	closed int
+}
+
+func (r *closeRecordingReader) Close() error {
+	r.closed++
+	return nil
+}
+
+func readConversationStream(stream *ConversationStream) []ConversationChunk {
+	var chunks []ConversationChunk
+	for stream.Next() {
+		chunks = append(chunks, stream.Chunk())
+	}
+	return chunks
+}
+
+func TestCollectionSearchStream(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/collections/movies/documents/search", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "can you suggest an action series", query.Get("q"))
+		assert.Equal(t, "true", query.Get("conversation"))
+		assert.Equal(t, "true", query.Get("conversation_stream"))
+		assert.Equal(t, "conv-model-1", query.Get("conversation_model_id"))
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Write([]byte(": keep-alive\n\n" +
+			"data: {\"conversation_id\": \"abc\", \"message\": \"Try \"}\n\n" +
+			"data: {\"conversation_id\": \"abc\", \"message\": \"Breaking Bad.\"}\n\n" +
+			"data: {\"found\": 1, \"hits\": [{\"document\": {\"id\": \"1\"}}]}\n\n" +
+			"data: [DONE]\n\n"))
+	})
+	defer server.Close()
+
+	stream, err := client.Collection("movies").Documents().SearchStream(context.Background(), &api.SearchCollectionParams{
+		Q:                   pointer.String("can you suggest an action series"),
+		QueryBy:             pointer.String("embedding"),
+		ConversationModelId: pointer.String("conv-model-1"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []ConversationChunk{
+		{ConversationId: "abc", Message: "Try "},
+		{ConversationId: "abc", Message: "Breaking Bad."},
+	}, readConversationStream(stream))
+	assert.NoError(t, stream.Err())
+	if assert.NotNil(t, stream.Result()) {
+		assert.Equal(t, pointer.Int(1), stream.Result().Found)
+	}
+	assert.False(t, stream.Next())
+	assert.NoError(t, stream.Close())
+}
+
+func TestCollectionSearchStreamOnHttpStatusErrorCodeReturnsError(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"message": "Conversation model not found"}`))
+	})
+	defer server.Close()
+
+	_, err := client.Collection("movies").Documents().SearchStream(context.Background(), &api.SearchCollectionParams{
+		Q: pointer.String("can you suggest an action series"),
+	})
+	assert.Equal(t, &HTTPError{Status: http.StatusBadRequest, Body: []byte(`{"message": "Conversation model not found"}`)}, err)
+}
+
+func TestConversationStreamClosesBodyOnCompletion(t *testing.T) {
+	body := &closeRecordingReader{Reader: strings.NewReader(
+		"data: {\"message\": \"multi\"}\r\n\r\ndata:{\"message\":\ndata: \"line\"}\n")}
+	stream := newConversationStream(body)
+	assert.Equal(t, []ConversationChunk{{Message: "multi"}, {Message: "line"}}, readConversationStream(stream))
+	assert.NoError(t, stream.Err())
+	assert.Nil(t, stream.Result())
+	assert.NoError(t, stream.Close())
+	assert.Equal(t, 1, body.closed)
+}
+
+func TestConversationStreamWithLargeEvent(t *testing.T) {
+	message := strings.Repeat("a", 1<<20)
+	body := &closeRecordingReader{Reader: strings.NewReader(
+		"data: {\"message\": \"" + message + "\"}\n\n" +
+			"data: {\"found\": 1, \"hits\": [{\"document\": {\"id\": \"1\", \"overview\": \"" + message + "\"}}]}\n\n" +
+			"data: [DONE]\n\n")}
+	stream := newConversationStream(body)
+	assert.Equal(t, []ConversationChunk{{Message: message}}, readConversationStream(stream))
+	assert.NoError(t, stream.Err())
+	if assert.NotNil(t, stream.Result()) {
+		assert.Equal(t, pointer.Int(1), stream.Result().Found)
+	}
+	assert.Equal(t, 1, body.closed)
+}
+
+func TestConversationStreamWithMalformedEventReturnsError(t *testing.T) {
+	body := &closeRecordingReader{Reader: strings.NewReader("data: {\"message\": \"Try \"}\n\ndata: {\"message\n\n")}
+	stream := newConversationStream(body)
+	assert.Equal(t, []ConversationChunk{{Message: "Try "}}, readConversationStream(stream))
+	assert.Error(t, stream.Err())
+	assert.Equal(t, 1, body.closed)
+}
+
+func TestConversationStreamCloseStopsIteration(t *testing.T) {
+	body := &closeRecordingReader{Reader: strings.NewReader("data: {\"message\": \"Try \"}\n\ndata: {\"message\": \"this\"}\n\n")}
+	stream := newConversationStream(body)
+	assert.True(t, stream.Next())
+	assert.NoError(t, stream.Close())
+	assert.False(t, stream.Next())
+	assert.Equal(t, 1, body.closed)
+}
@@ -1,29 +1,49 @@
 package typesense
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"strings"
+
+	"github.com/typesense/typesense-go/v2/typesense/api"
 )
 
 type DocumentInterface[T any] interface {
 	Retrieve(ctx context.Context) (T, error)
+	// RetrieveWithParams retrieves the document, projected to the fields
+	// selected by the include_fields and exclude_fields params
+	RetrieveWithParams(ctx context.Context, params *api.GetDocumentParams) (T, error)
 	Update(ctx context.Context, document any) (T, error)
+	// Emplace indexes the document under the id of this document with the emplace
+	// action: the document is created if it does not exist, otherwise the given
+	// fields are updated
+	Emplace(ctx context.Context, document any) (T, error)
 	Delete(ctx context.Context) (T, error)
 }
 
 var _ DocumentInterface[any] = (*document[any])(nil)
 
 type document[T any] struct {
-	apiClient      APIClientInterface
-	collectionName string
-	documentID     string
+	apiClient        APIClientInterface
+	collectionName   string
+	documentID       string
+	structTag        string
+	marshalDocuments bool
 }
 
 func (d *document[T]) Retrieve(ctx context.Context) (resp T, err error) {
+	return d.RetrieveWithParams(ctx, &api.GetDocumentParams{})
+}
+
+func (d *document[T]) RetrieveWithParams(ctx context.Context, params *api.GetDocumentParams) (resp T, err error) {
+	if err := validateCollectionName(d.collectionName); err != nil {
+		return resp, err
+	}
 	response, err := d.apiClient.GetDocument(ctx,
-		d.collectionName, d.documentID)
+		d.collectionName, d.documentID, params)
 	if err != nil {
 		return resp, err
 	}
@@ -32,7 +52,7 @@ func (d *document[T]) Retrieve(ctx context.Context) (resp T, err error) {
 		response.Body.Close()
 		return resp, &HTTPError{Status: response.StatusCode, Body: body}
 	}
-	err = json.NewDecoder(response.Body).Decode(&resp)
+	err = decodeDocument(response.Body, &resp, d.structTag)
 	if err != nil {
 		return resp, err
 	}
@@ -40,6 +60,9 @@ func (d *document[T]) Retrieve(ctx context.Context) (resp T, err error) {
 }
 
 func (d *document[T]) Update(ctx context.Context, document any) (resp T, err error) {
+	if err := validateCollectionName(d.collectionName); err != nil {
+		return resp, err
+	}
 	response, err := d.apiClient.UpdateDocument(ctx,
 		d.collectionName, d.documentID, document)
 	if err != nil {
@@ -50,7 +73,7 @@ func (d *document[T]) Update(ctx context.Context, document any) (resp T, err err
 		response.Body.Close()
 		return resp, &HTTPError{Status: response.StatusCode, Body: body}
 	}
-	err = json.NewDecoder(response.Body).Decode(&resp)
+	err = decodeDocument(response.Body, &resp, d.structTag)
 	if err != nil {
 		return resp, err
 	}
@@ -58,6 +81,9 @@ func (d *document[T]) Update(ctx context.Context, document any) (resp T, err err
 }
 
 func (d *document[T]) Delete(ctx context.Context) (resp T, err error) {
+	if err := validateCollectionName(d.collectionName); err != nil {
+		return resp, err
+	}
 	response, err := d.apiClient.DeleteDocument(ctx,
 		d.collectionName, d.documentID)
 	if err != nil {
@@ -68,10 +94,60 @@ func (d *document[T]) Delete(ctx context.Context) (resp T, err error) {
 		response.Body.Close()
 		return resp, &HTTPError{Status: response.StatusCode, Body: body}
 	}
-	err = json.NewDecoder(response.Body).Decode(&resp)
+	err = decodeDocument(response.Body, &resp, d.structTag)
 	if err != nil {
 		return resp, err
 	}
 
 	return resp, nil
 }
+
+func (d *document[T]) Emplace(ctx context.Context, document any) (resp T, err error) {
+	if err := validateCollectionName(d.collectionName); err != nil {
+		return resp, err
+	}
+	body, err := documentWithID(document, d.documentID, d.marshalDocuments)
+	if err != nil {
+		return resp, err
+	}
+	response, err := d.apiClient.IndexDocument(ctx,
+		d.collectionName, &api.IndexDocumentParams{Action: &emplaceAction}, body)
+	if err != nil {
+		return resp, err
+	}
+	if !(strings.Contains(response.Header.Get("Content-Type"), "json") && response.StatusCode == 201) {
+		body, _ := io.ReadAll(response.Body)
+		response.Body.Close()
+		return resp, &HTTPError{Status: response.StatusCode, Body: body}
+	}
+	err = decodeDocument(response.Body, &resp, d.structTag)
+	if err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// documentWithID converts the document to a map with the id field set to id.
+// A document with a different id is rejected.
+func documentWithID(document any, id string, marshalStructs bool) (map[string]any, error) {
+	document, err := encodeDocument(document, marshalStructs)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]any{}
+	jsonDecoder := json.NewDecoder(bytes.NewReader(data))
+	// keep integers such as int64 fields exact
+	jsonDecoder.UseNumber()
+	if err := jsonDecoder.Decode(&fields); err != nil {
+		return nil, err
+	}
+	if documentID, ok := fields["id"]; ok && documentID != id {
+		return nil, fmt.Errorf("document id %v does not match %q", documentID, id)
+	}
+	fields["id"] = id
+	return fields, nil
+}
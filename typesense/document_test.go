@@ -2,10 +2,14 @@ package typesense
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/typesense/typesense-go/v2/typesense/api"
+	"github.com/typesense/typesense-go/v2/typesense/api/pointer"
 	"github.com/typesense/typesense-go/v2/typesense/mocks"
 	"go.uber.org/mock/gomock"
 )
@@ -19,7 +23,7 @@ func TestDocumentRetrieve(t *testing.T) {
 	mockedResult := createNewDocumentResponse()
 
 	mockAPIClient.EXPECT().
-		GetDocument(gomock.Not(gomock.Nil()), "companies", "123").
+		GetDocument(gomock.Not(gomock.Nil()), "companies", "123", &api.GetDocumentParams{}).
 		Return(createResponse(200, "", mockedResult), nil).
 		Times(1)
 
@@ -30,13 +34,194 @@ func TestDocumentRetrieve(t *testing.T) {
 	assert.Equal(t, expectedResult, result)
 }
 
+func TestDocumentRetrieveWithParams(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r,
+			"/collections/companies/documents/123?exclude_fields=country&include_fields=companyName%2CnumEmployees",
+			http.MethodGet)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"companyName": "Stark Industries", "numEmployees": 5215}`))
+	})
+	defer server.Close()
+
+	result, err := client.Collection("companies").Document("123").RetrieveWithParams(context.Background(),
+		&api.GetDocumentParams{
+			IncludeFields: pointer.String("companyName,numEmployees"),
+			ExcludeFields: pointer.String("country"),
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"companyName": "Stark Industries", "numEmployees": float64(5215)}, result)
+}
+
+func TestDocumentRetrieveWithNestedExcludeFields(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "exclude_fields=author.email%2Cauthor.address.street", r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "123", "author": {"name": "Jane"}}`))
+	})
+	defer server.Close()
+
+	result, err := client.Collection("books").Document("123").RetrieveWithParams(context.Background(),
+		&api.GetDocumentParams{ExcludeFields: pointer.String("author.email,author.address.street")})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": "123", "author": map[string]interface{}{"name": "Jane"}}, result)
+}
+
+func TestDocumentRetrieveKeepsNumericStringID(t *testing.T) {
+	type product struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+
+	// 2^53 + 1 cannot be represented exactly as a float64
+	const id = "9007199254740993"
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "` + id + `", "name": "Shoes"}`))
+	})
+	defer server.Close()
+
+	typed, err := GenericCollection[product](client, "products").Document(id).Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, product{ID: id, Name: "Shoes"}, typed)
+
+	untyped, err := client.Collection("products").Document(id).Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, id, untyped["id"])
+}
+
+func TestDocumentRetrieveDoesNotCoerceStringIDToNumber(t *testing.T) {
+	type product struct {
+		ID int64 `json:"id"`
+	}
+
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "123"}`))
+	})
+	defer server.Close()
+
+	_, err := GenericCollection[product](client, "products").Document("123").Retrieve(context.Background())
+	assert.Error(t, err)
+}
+
+func TestDocumentEscapesIDInPath(t *testing.T) {
+	tests := []struct {
+		name        string
+		documentID  string
+		expectedURI string
+	}{
+		{
+			name:        "slashes",
+			documentID:  "https://example.com/products/1",
+			expectedURI: "/collections/companies/documents/https:%2F%2Fexample.com%2Fproducts%2F1",
+		},
+		{
+			name:        "spaces",
+			documentID:  "Stark Industries",
+			expectedURI: "/collections/companies/documents/Stark%20Industries",
+		},
+		{
+			name:        "unicode",
+			documentID:  "café-ü",
+			expectedURI: "/collections/companies/documents/caf%C3%A9-%C3%BC",
+		},
+		{
+			name:        "reserved characters",
+			documentID:  "a?b#c%2F",
+			expectedURI: "/collections/companies/documents/a%3Fb%23c%252F",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectedMethods := []string{http.MethodGet, http.MethodPatch, http.MethodDelete}
+			server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+				validateRequestMetadata(t, r, tt.expectedURI, expectedMethods[0])
+				assert.Equal(t, "/collections/companies/documents/"+tt.documentID, r.URL.Path)
+				expectedMethods = expectedMethods[1:]
+
+				w.Header().Set("Content-Type", "application/json")
+				w.Write(jsonEncode(t, map[string]any{"id": tt.documentID}))
+			})
+			defer server.Close()
+
+			document := client.Collection("companies").Document(tt.documentID)
+			retrieved, err := document.Retrieve(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.documentID, retrieved["id"])
+
+			_, err = document.Update(context.Background(), map[string]any{"num_employees": 10})
+			assert.NoError(t, err)
+
+			_, err = document.Delete(context.Background())
+			assert.NoError(t, err)
+			assert.Empty(t, expectedMethods)
+		})
+	}
+}
+
+func TestDocumentEmplace(t *testing.T) {
+	type company struct {
+		ID           string `json:"id,omitempty"`
+		CompanyName  string `json:"company_name"`
+		NumEmployees int64  `json:"num_employees"`
+	}
+
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r, "/collections/companies/documents?action=emplace", http.MethodPost)
+		var body map[string]any
+		err := json.NewDecoder(r.Body).Decode(&body)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"id": "123", "company_name": "Stark Industries", "num_employees": float64(5215)}, body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write(jsonEncode(t, body))
+	})
+	defer server.Close()
+
+	result, err := GenericCollection[company](client, "companies").Document("123").Emplace(context.Background(),
+		company{CompanyName: "Stark Industries", NumEmployees: 5215})
+	assert.NoError(t, err)
+	assert.Equal(t, company{ID: "123", CompanyName: "Stark Industries", NumEmployees: 5215}, result)
+
+	_, err = client.Collection("companies").Document("123").Emplace(context.Background(),
+		map[string]any{"id": "123", "company_name": "Stark Industries", "num_employees": 5215})
+	assert.NoError(t, err)
+}
+
+func TestDocumentEmplaceWithMismatchingIDReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Collection("companies").Document("123").Emplace(context.Background(),
+		map[string]any{"id": "124", "company_name": "Stark Industries"})
+	assert.EqualError(t, err, `document id 124 does not match "123"`)
+}
+
+func TestDocumentEmplaceOnHttpStatusErrorCodeReturnsError(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Bad Request"))
+	})
+	defer server.Close()
+
+	_, err := client.Collection("companies").Document("123").Emplace(context.Background(),
+		map[string]any{"company_name": "Stark Industries"})
+	assert.Equal(t, &HTTPError{Status: http.StatusBadRequest, Body: []byte("Bad Request")}, err)
+}
+
 func TestDocumentRetrieveOnApiClientErrorReturnsError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
 
 	mockAPIClient.EXPECT().
-		GetDocument(gomock.Not(gomock.Nil()), "companies", "123").
+		GetDocument(gomock.Not(gomock.Nil()), "companies", "123", &api.GetDocumentParams{}).
 		Return(nil, errors.New("failed request")).
 		Times(1)
 
@@ -51,7 +236,7 @@ func TestDocumentRetrieveOnHttpStatusErrorCodeReturnsError(t *testing.T) {
 	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
 
 	mockAPIClient.EXPECT().
-		GetDocument(gomock.Not(gomock.Nil()), "companies", "123").
+		GetDocument(gomock.Not(gomock.Nil()), "companies", "123", &api.GetDocumentParams{}).
 		Return(createResponse(500, "Internal server error", nil), nil).
 		Times(1)
 
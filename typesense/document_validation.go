@@ -0,0 +1,130 @@
+package typesense
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/typesense/typesense-go/v2/typesense/api"
+)
+
+// DocumentViolation describes a field of a document that does not match the
+// collection schema
+type DocumentViolation struct {
+	// Index is the position of the document in the validated documents
+	Index   int
+	Field   string
+	Message string
+}
+
+func (v DocumentViolation) String() string {
+	return fmt.Sprintf("document %d: field %s %s", v.Index, v.Field, v.Message)
+}
+
+// validateDocuments checks the JSONL documents read from body against the schema fields
+func validateDocuments(fields []api.Field, body io.Reader) ([]DocumentViolation, error) {
+	violations := []DocumentViolation{}
+	jsonDecoder := json.NewDecoder(body)
+	jsonDecoder.UseNumber()
+	for index := 0; jsonDecoder.More(); index++ {
+		var document map[string]interface{}
+		if err := jsonDecoder.Decode(&document); err != nil {
+			return nil, fmt.Errorf("failed to decode document %d: %w", index, err)
+		}
+		for _, field := range fields {
+			if message := validateDocumentField(field, document); message != "" {
+				violations = append(violations, DocumentViolation{Index: index, Field: field.Name, Message: message})
+			}
+		}
+	}
+	return violations, nil
+}
+
+func validateDocumentField(field api.Field, document map[string]interface{}) string {
+	// wildcard fields match by pattern and embedding fields are generated by the server
+	if field.Name == "id" || strings.Contains(field.Name, "*") || field.Embed != nil {
+		return ""
+	}
+	value, ok := lookupDocumentField(document, field.Name)
+	if !ok || value == nil {
+		if field.Optional != nil && *field.Optional {
+			return ""
+		}
+		return "is required"
+	}
+	if !matchesFieldType(field.Type, value) {
+		return fmt.Sprintf("must be of type %s", field.Type)
+	}
+	return ""
+}
+
+// lookupDocumentField finds the value of a field, addressing nested fields with dotted names
+func lookupDocumentField(document map[string]interface{}, name string) (interface{}, bool) {
+	if value, ok := document[name]; ok {
+		return value, true
+	}
+	head, rest, found := strings.Cut(name, ".")
+	if !found {
+		return nil, false
+	}
+	nested, ok := document[head].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	return lookupDocumentField(nested, rest)
+}
+
+func matchesFieldType(fieldType string, value interface{}) bool {
+	if strings.HasSuffix(fieldType, "[]") {
+		elemType := strings.TrimSuffix(fieldType, "[]")
+		values, ok := value.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, v := range values {
+			if !matchesFieldType(elemType, v) {
+				return false
+			}
+		}
+		return true
+	}
+
+	switch fieldType {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "string*":
+		return matchesFieldType("string", value) || matchesFieldType("string[]", value)
+	case "int32":
+		n, ok := integerValue(value)
+		return ok && n >= math.MinInt32 && n <= math.MaxInt32
+	case "int64":
+		_, ok := integerValue(value)
+		return ok
+	case "float":
+		_, ok := value.(json.Number)
+		return ok
+	case "bool":
+		_, ok := value.(bool)
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "geopoint":
+		return matchesFieldType("float[]", value) && len(value.([]interface{})) == 2
+	default:
+		// auto, image and other types are not checked
+		return true
+	}
+}
+
+func integerValue(value interface{}) (int64, bool) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	n, err := number.Int64()
+	return n, err == nil
+}
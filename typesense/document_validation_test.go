@@ -0,0 +1,164 @@
+package typesense
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/typesense/typesense-go/v2/typesense/api"
+	"github.com/typesense/typesense-go/v2/typesense/api/pointer"
+	"github.com/typesense/typesense-go/v2/typesense/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func newValidationTestClient(t *testing.T) *Client {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		GetCollectionWithResponse(gomock.Not(gomock.Nil()), "companies").
+		Return(&api.GetCollectionResponse{
+			JSON200: &api.CollectionResponse{
+				Name: "companies",
+				Fields: []api.Field{
+					{Name: "company_name", Type: "string"},
+					{Name: "num_employees", Type: "int32"},
+					{Name: "revenue", Type: "float", Optional: pointer.True()},
+					{Name: "tags", Type: "string[]", Optional: pointer.True()},
+					{Name: "location", Type: "geopoint", Optional: pointer.True()},
+					{Name: "address.city", Type: "string", Optional: pointer.True()},
+					{Name: ".*_facet", Type: "auto"},
+				},
+				EnableNestedFields: pointer.True(),
+			},
+		}, nil).
+		Times(1)
+
+	return NewClient(WithAPIClient(mockAPIClient))
+}
+
+func TestDocumentsValidate(t *testing.T) {
+	client := newValidationTestClient(t)
+
+	violations, err := client.Collection("companies").Documents().Validate(context.Background(),
+		[]map[string]interface{}{
+			{
+				"id":            "123",
+				"company_name":  "Stark Industries",
+				"num_employees": 5215,
+				"revenue":       10,
+				"tags":          []string{"tech", "defense"},
+				"location":      []float64{48.85, 2.35},
+				"address":       map[string]interface{}{"city": "New York"},
+			},
+			{
+				"company_name":  "Wayne Enterprises",
+				"num_employees": 1000,
+			},
+		})
+
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+}
+
+func TestDocumentsValidateWithMissingRequiredFields(t *testing.T) {
+	client := newValidationTestClient(t)
+
+	violations, err := client.Collection("companies").Documents().Validate(context.Background(),
+		[]map[string]interface{}{
+			{"company_name": "Stark Industries", "num_employees": 5215},
+			{"company_name": "Wayne Enterprises"},
+			{"company_name": nil, "num_employees": 10},
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []DocumentViolation{
+		{Index: 1, Field: "num_employees", Message: "is required"},
+		{Index: 2, Field: "company_name", Message: "is required"},
+	}, violations)
+	assert.Equal(t, "document 1: field num_employees is required", violations[0].String())
+}
+
+func TestDocumentsValidateWithTypeMismatches(t *testing.T) {
+	type company struct {
+		CompanyName  interface{} `json:"company_name"`
+		NumEmployees interface{} `json:"num_employees"`
+		Revenue      interface{} `json:"revenue,omitempty"`
+		Tags         interface{} `json:"tags,omitempty"`
+		Location     interface{} `json:"location,omitempty"`
+		Address      interface{} `json:"address,omitempty"`
+	}
+
+	client := newValidationTestClient(t)
+
+	violations, err := client.Collection("companies").Documents().Validate(context.Background(),
+		[]company{
+			{CompanyName: 123, NumEmployees: "5215"},
+			{CompanyName: "Stark Industries", NumEmployees: 52.5, Revenue: "high"},
+			{CompanyName: "Stark Industries", NumEmployees: int64(1) << 40},
+			{CompanyName: "Stark Industries", NumEmployees: 1, Tags: []interface{}{"tech", 1}, Location: []float64{48.85}},
+			{CompanyName: "Stark Industries", NumEmployees: 1, Address: map[string]interface{}{"city": 42}},
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []DocumentViolation{
+		{Index: 0, Field: "company_name", Message: "must be of type string"},
+		{Index: 0, Field: "num_employees", Message: "must be of type int32"},
+		{Index: 1, Field: "num_employees", Message: "must be of type int32"},
+		{Index: 1, Field: "revenue", Message: "must be of type float"},
+		{Index: 2, Field: "num_employees", Message: "must be of type int32"},
+		{Index: 3, Field: "tags", Message: "must be of type string[]"},
+		{Index: 3, Field: "location", Message: "must be of type geopoint"},
+		{Index: 4, Field: "address.city", Message: "must be of type string"},
+	}, violations)
+}
+
+func TestDocumentsValidateWithEmptyListReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Collection("companies").Documents().Validate(context.Background(), []interface{}{})
+	assert.EqualError(t, err, "documents list is empty")
+}
+
+func TestDocumentsValidateOnApiClientErrorReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		GetCollectionWithResponse(gomock.Not(gomock.Nil()), "companies").
+		Return(nil, errors.New("failed request")).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Collection("companies").Documents().Validate(context.Background(),
+		[]interface{}{map[string]interface{}{"company_name": "Stark Industries"}})
+	assert.NotNil(t, err)
+}
+
+func TestDocumentsValidateOnHttpStatusErrorCodeReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		GetCollectionWithResponse(gomock.Not(gomock.Nil()), "companies").
+		Return(&api.GetCollectionResponse{
+			HTTPResponse: &http.Response{
+				StatusCode: 404,
+			},
+			Body: []byte("Not Found"),
+		}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Collection("companies").Documents().Validate(context.Background(),
+		[]interface{}{map[string]interface{}{"company_name": "Stark Industries"}})
+	assert.Equal(t, &HTTPError{Status: 404, Body: []byte("Not Found")}, err)
+}
@@ -5,17 +5,42 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"reflect"
+	"strings"
 
 	"github.com/typesense/typesense-go/v2/typesense/api"
+	"github.com/typesense/typesense-go/v2/typesense/api/pointer"
 )
 
-var upsertAction api.IndexDocumentParamsAction = "upsert"
+var (
+	upsertAction  api.IndexDocumentParamsAction = "upsert"
+	emplaceAction api.IndexDocumentParamsAction = "emplace"
+)
+
+// ErrVersionConflict is returned by CompareAndSwap when the document does not
+// have the expected version, e.g. because it was updated concurrently, or does not exist.
+var ErrVersionConflict = errors.New("document version conflict")
 
 const (
 	defaultImportBatchSize = 40
 	defaultImportAction    = "create"
+	defaultSearchPerPage   = 10
+	// deleteManyChunkSize is the number of ids in the filter of each request of DeleteMany
+	deleteManyChunkSize = 100
+)
+
+const (
+	// MaxPerPage is the maximum value of the per_page search parameter accepted by the server.
+	MaxPerPage = 250
+	// MaxSearchHits is the maximum number of hits that can be paginated through,
+	// i.e. page * per_page must not exceed it. The server side limit_hits setting,
+	// which is usually set in a scoped API key, is not known to the client and is
+	// not taken into account.
+	MaxSearchHits = math.MaxUint32
 )
 
 // DocumentsInterface is a type for Documents API operations
@@ -26,28 +51,111 @@ type DocumentsInterface interface {
 	Update(ctx context.Context, updateFields interface{}, params *api.UpdateDocumentsParams) (int, error)
 	// Upsert returns indexed/updated document
 	Upsert(context.Context, interface{}) (map[string]interface{}, error)
+	// Emplace imports the documents with the emplace action: new documents are
+	// created and the given fields of existing documents are updated
+	Emplace(ctx context.Context, documents any, params *api.ImportDocumentsParams) ([]*api.ImportDocumentResponse, error)
 	// Delete returns number of deleted documents
 	Delete(ctx context.Context, filter *api.DeleteDocumentsParams) (int, error)
-	// Search performs document search in collection
+	// DeleteMany deletes the documents with the given ids, sending the ids in chunks
+	// to keep the filters short, and returns the number of deleted documents.
+	// On error, the number of documents deleted by the previous chunks is returned.
+	// Ids containing a backtick are rejected before any document is deleted.
+	DeleteMany(ctx context.Context, ids []string) (int, error)
+	// CompareAndSwap updates the document with the given id only if its integer
+	// versionField equals expectedVersion, setting the field to expectedVersion+1,
+	// and returns the new version. ErrVersionConflict is returned if no document
+	// with the id and the expected version exists.
+	CompareAndSwap(ctx context.Context, id string, versionField string, expectedVersion int64, document any) (int64, error)
+	// DeleteWithResult returns the typed result of deleting documents by filter
+	DeleteWithResult(ctx context.Context, filter *api.DeleteDocumentsParams) (*api.DeleteDocumentsResult, error)
+	// Search performs document search in collection. The page and per_page params
+	// are validated before the request is sent, see MaxPerPage and MaxSearchHits.
 	Search(ctx context.Context, params *api.SearchCollectionParams) (*api.SearchResult, error)
+	// SearchAll performs a wildcard search (q=*) matching all documents in collection,
+	// e.g. to browse documents with filter_by, sort_by, facets and pagination.
+	// query_by is not required.
+	SearchAll(ctx context.Context, params *api.SearchCollectionParams) (*api.SearchResult, error)
+	// SearchNoHighlight performs document search in collection with highlighting of
+	// all fields disabled, saving server CPU for queries whose hits are not displayed.
+	SearchNoHighlight(ctx context.Context, params *api.SearchCollectionParams) (*api.SearchResult, error)
+	// SearchStream performs a conversational search streaming the answer, setting
+	// the conversation and conversation_stream params. The overall request timeout of
+	// the client, set with WithTimeout or WithConnectionTimeout (5 seconds by default),
+	// also covers reading the stream, so an answer streamed for longer fails partway
+	// through. For long answers use a client without an overall timeout, e.g. created
+	// with WithConnectionTimeout(0) and WithResponseHeaderTimeout, and bound the
+	// stream with ctx instead.
+	SearchStream(ctx context.Context, params *api.SearchCollectionParams) (*ConversationStream, error)
+	// SearchRaw performs document search in collection with arbitrary query params,
+	// e.g. for search parameters not yet available in api.SearchCollectionParams
+	SearchRaw(ctx context.Context, params map[string]string) (*api.SearchResult, error)
 	// Export returns all documents from index in jsonl format
 	Export(ctx context.Context) (io.ReadCloser, error)
+	// ExportTo streams the documents matching params in jsonl format to w
+	// and returns the number of exported documents
+	ExportTo(ctx context.Context, w io.Writer, params *api.ExportDocumentsParams) (int, error)
+	// Scroll returns an iterator over all documents of the collection, reading
+	// pageSize documents at a time from an export of the collection
+	Scroll(ctx context.Context, pageSize int) (*ScrollIterator, error)
 	// Import returns json array. Each item of the response indicates
 	// the result of each document present in the request body (in the same order).
-	Import(ctx context.Context, documents []interface{}, params *api.ImportDocumentsParams) ([]*api.ImportDocumentResponse, error)
+	// The documents can be passed as a slice of documents (e.g. []interface{},
+	// []map[string]interface{} or []MyStruct), as pre-serialized JSONL []byte or
+	// as an io.Reader of JSONL.
+	Import(ctx context.Context, documents any, params *api.ImportDocumentsParams) ([]*api.ImportDocumentResponse, error)
 	// ImportJsonl accepts documents and returns result in jsonl format. Each line of the
 	// response indicates the result of each document present in the
-	// request body (in the same order).
+	// request body (in the same order). The body is read until ctx is done but it is
+	// never closed, even if it implements io.Closer: closing it stays up to the caller.
 	ImportJsonl(ctx context.Context, body io.Reader, params *api.ImportDocumentsParams) (io.ReadCloser, error)
+	// ImportFromChannel imports the documents received from the channel in batches of
+	// params.BatchSize and streams back the result of each document. The partial last
+	// batch is imported when the documents channel is closed. The results channel is
+	// closed once all the documents are imported or when ctx is done.
+	// A batch rejected with ErrRequestEntityTooLarge is retried in halves and the
+	// smaller batch size is kept for the following batches.
+	ImportFromChannel(ctx context.Context, documents <-chan any, params *api.ImportDocumentsParams) (<-chan api.ImportResult, error)
+	// Validate checks the documents against the collection schema without indexing
+	// them and returns the missing required fields and type mismatches.
+	// The documents can be passed in the same forms as for Import.
+	Validate(ctx context.Context, documents any) ([]DocumentViolation, error)
 }
 
 // documents is internal implementation of DocumentsInterface
 type documents struct {
-	apiClient      APIClientInterface
-	collectionName string
+	apiClient        APIClientInterface
+	collectionName   string
+	schemaCache      *schemaCache
+	marshalDocuments bool
+}
+
+// encodeDocument encodes documents implementing api.DocumentMarshaler, and structs
+// if marshalStructs is set, with api.MarshalDocument. Other documents are serialized as is.
+func encodeDocument(document interface{}, marshalStructs bool) (interface{}, error) {
+	if marshaler, ok := document.(api.DocumentMarshaler); ok {
+		return marshaler.MarshalDocument()
+	}
+	if !marshalStructs {
+		return document, nil
+	}
+	value := reflect.ValueOf(document)
+	if value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() == reflect.Struct {
+		return api.MarshalDocument(document)
+	}
+	return document, nil
 }
 
 func (d *documents) indexDocument(ctx context.Context, document interface{}, params *api.IndexDocumentParams) (map[string]interface{}, error) {
+	if err := validateCollectionName(d.collectionName); err != nil {
+		return nil, err
+	}
+	document, err := encodeDocument(document, d.marshalDocuments)
+	if err != nil {
+		return nil, err
+	}
 	response, err := d.apiClient.IndexDocumentWithResponse(ctx,
 		d.collectionName, params, document)
 	if err != nil {
@@ -64,6 +172,9 @@ func (d *documents) Create(ctx context.Context, document interface{}) (map[strin
 }
 
 func (d *documents) Update(ctx context.Context, updateFields interface{}, params *api.UpdateDocumentsParams) (int, error) {
+	if err := validateCollectionName(d.collectionName); err != nil {
+		return 0, err
+	}
 	response, err := d.apiClient.UpdateDocumentsWithResponse(ctx,
 		d.collectionName, params, updateFields)
 	if err != nil {
@@ -79,19 +190,147 @@ func (d *documents) Upsert(ctx context.Context, document interface{}) (map[strin
 	return d.indexDocument(ctx, document, &api.IndexDocumentParams{Action: &upsertAction})
 }
 
+func (d *documents) Emplace(ctx context.Context, documents any, params *api.ImportDocumentsParams) ([]*api.ImportDocumentResponse, error) {
+	emplaceParams := api.ImportDocumentsParams{}
+	if params != nil {
+		emplaceParams = *params
+	}
+	emplaceParams.Action = pointer.String(string(emplaceAction))
+	return d.Import(ctx, documents, &emplaceParams)
+}
+
 func (d *documents) Delete(ctx context.Context, filter *api.DeleteDocumentsParams) (int, error) {
+	result, err := d.DeleteWithResult(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return result.NumDeleted, nil
+}
+
+func (d *documents) DeleteMany(ctx context.Context, ids []string) (int, error) {
+	// all filters are built first, so that an invalid id fails before anything is deleted
+	var filters []string
+	for start := 0; start < len(ids); start += deleteManyChunkSize {
+		end := start + deleteManyChunkSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		filter, err := idsFilter(ids[start:end])
+		if err != nil {
+			return 0, err
+		}
+		filters = append(filters, filter)
+	}
+	total := 0
+	for _, filter := range filters {
+		numDeleted, err := d.Delete(ctx, &api.DeleteDocumentsParams{FilterBy: pointer.String(filter)})
+		if err != nil {
+			return total, err
+		}
+		total += numDeleted
+	}
+	return total, nil
+}
+
+func (d *documents) CompareAndSwap(ctx context.Context, id string, versionField string, expectedVersion int64, document any) (int64, error) {
+	if versionField == "" || versionField == "id" {
+		return 0, fmt.Errorf("invalid version field %q", versionField)
+	}
+	// an id breaking out of the filter quoting would widen the update to other documents
+	idFilter, err := idsFilter([]string{id})
+	if err != nil {
+		return 0, err
+	}
+	fields, err := documentWithID(document, id, d.marshalDocuments)
+	if err != nil {
+		return 0, err
+	}
+	// the id is matched by the filter and is not updatable
+	delete(fields, "id")
+	version := expectedVersion + 1
+	fields[versionField] = version
+	filter := fmt.Sprintf("%s && %s:=%d", idFilter, versionField, expectedVersion)
+	numUpdated, err := d.Update(ctx, fields, &api.UpdateDocumentsParams{FilterBy: pointer.String(filter)})
+	if err != nil {
+		return 0, err
+	}
+	if numUpdated == 0 {
+		return 0, ErrVersionConflict
+	}
+	return version, nil
+}
+
+// idsFilter builds a filter matching the documents with the given ids. The ids are
+// enclosed in backticks so that commas and other special characters are matched as is;
+// ids containing a backtick are rejected with api.ErrInvalidFilter.
+func idsFilter(ids []string) (string, error) {
+	return api.Filterf("id:%s", ids)
+}
+
+func (d *documents) DeleteWithResult(ctx context.Context, filter *api.DeleteDocumentsParams) (*api.DeleteDocumentsResult, error) {
+	if err := validateCollectionName(d.collectionName); err != nil {
+		return nil, err
+	}
 	response, err := d.apiClient.DeleteDocumentsWithResponse(ctx,
 		d.collectionName, filter)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	if response.JSON200 == nil {
-		return 0, &HTTPError{Status: response.StatusCode(), Body: response.Body}
+		return nil, &HTTPError{Status: response.StatusCode(), Body: response.Body}
+	}
+	return &api.DeleteDocumentsResult{NumDeleted: response.JSON200.NumDeleted}, nil
+}
+
+// validateSearchPagination checks page and per_page against MaxPerPage and
+// MaxSearchHits. Pages beyond limit_hits are left to the server to reject, as
+// limit_hits is not a field of api.SearchCollectionParams.
+func validateSearchPagination(page *int, perPage *int) error {
+	if perPage != nil && (*perPage < 0 || *perPage > MaxPerPage) {
+		return fmt.Errorf("invalid search parameter per_page: %d, must be between 0 and %d", *perPage, MaxPerPage)
+	}
+	if page == nil {
+		return nil
+	}
+	if *page < 0 {
+		return fmt.Errorf("invalid search parameter page: %d, must not be negative", *page)
+	}
+	hitsPerPage := defaultSearchPerPage
+	if perPage != nil {
+		hitsPerPage = *perPage
 	}
-	return response.JSON200.NumDeleted, nil
+	if int64(*page)*int64(hitsPerPage) > MaxSearchHits {
+		return fmt.Errorf("invalid search parameter page: %d, page * per_page must not exceed %d", *page, int64(MaxSearchHits))
+	}
+	return nil
 }
 
 func (d *documents) Search(ctx context.Context, params *api.SearchCollectionParams) (*api.SearchResult, error) {
+	response, err := d.search(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return response.JSON200, nil
+}
+
+// search validates the params and performs the search, returning the successful response
+func (d *documents) search(ctx context.Context, params *api.SearchCollectionParams) (*api.SearchCollectionResponse, error) {
+	if err := validateCollectionName(d.collectionName); err != nil {
+		return nil, err
+	}
+	if err := validateSearchPagination(params.Page, params.PerPage); err != nil {
+		return nil, err
+	}
+	if params.Prefix != nil && params.QueryBy != nil {
+		if err := api.ValidatePrefix(*params.Prefix, *params.QueryBy); err != nil {
+			return nil, err
+		}
+	}
+	if params.NumTypos != nil && params.QueryBy != nil {
+		if err := api.ValidateNumTypos(*params.NumTypos, *params.QueryBy); err != nil {
+			return nil, err
+		}
+	}
 	response, err := d.apiClient.SearchCollectionWithResponse(ctx,
 		d.collectionName, params)
 	if err != nil {
@@ -100,10 +339,85 @@ func (d *documents) Search(ctx context.Context, params *api.SearchCollectionPara
 	if response.JSON200 == nil {
 		return nil, &HTTPError{Status: response.StatusCode(), Body: response.Body}
 	}
+	return response, nil
+}
+
+func (d *documents) SearchAll(ctx context.Context, params *api.SearchCollectionParams) (*api.SearchResult, error) {
+	wildcardParams := api.SearchCollectionParams{}
+	if params != nil {
+		wildcardParams = *params
+	}
+	wildcardParams.Q = pointer.String("*")
+	return d.Search(ctx, &wildcardParams)
+}
+
+// noHighlightFields is the highlight_fields value disabling highlighting
+const noHighlightFields = "none"
+
+func (d *documents) SearchNoHighlight(ctx context.Context, params *api.SearchCollectionParams) (*api.SearchResult, error) {
+	noHighlightParams := api.SearchCollectionParams{}
+	if params != nil {
+		noHighlightParams = *params
+	}
+	noHighlightParams.HighlightFields = pointer.String(noHighlightFields)
+	noHighlightParams.HighlightFullFields = pointer.String(noHighlightFields)
+	noHighlightParams.EnableHighlightV1 = pointer.False()
+	return d.Search(ctx, &noHighlightParams)
+}
+
+func (d *documents) SearchStream(ctx context.Context, params *api.SearchCollectionParams) (*ConversationStream, error) {
+	if err := validateCollectionName(d.collectionName); err != nil {
+		return nil, err
+	}
+	streamParams := api.SearchCollectionParams{}
+	if params != nil {
+		streamParams = *params
+	}
+	streamParams.Conversation = pointer.True()
+	streamParams.ConversationStream = pointer.True()
+	response, err := d.apiClient.SearchCollection(ctx, d.collectionName, &streamParams)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		defer response.Body.Close()
+		body, _ := io.ReadAll(response.Body)
+		return nil, &HTTPError{Status: response.StatusCode, Body: body}
+	}
+	return newConversationStream(response.Body), nil
+}
+
+func (d *documents) SearchRaw(ctx context.Context, params map[string]string) (*api.SearchResult, error) {
+	if err := validateCollectionName(d.collectionName); err != nil {
+		return nil, err
+	}
+	response, err := d.apiClient.SearchCollectionWithResponse(ctx,
+		d.collectionName, &api.SearchCollectionParams{}, withRawQueryParams(params))
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, &HTTPError{Status: response.StatusCode(), Body: response.Body}
+	}
 	return response.JSON200, nil
 }
 
+// withRawQueryParams returns a request editor setting the given query params
+func withRawQueryParams(params map[string]string) api.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		query := req.URL.Query()
+		for name, value := range params {
+			query.Set(name, value)
+		}
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
+
 func (d *documents) Export(ctx context.Context) (io.ReadCloser, error) {
+	if err := validateCollectionName(d.collectionName); err != nil {
+		return nil, err
+	}
 	response, err := d.apiClient.ExportDocuments(ctx, d.collectionName, &api.ExportDocumentsParams{})
 	if err != nil {
 		return nil, err
@@ -116,6 +430,62 @@ func (d *documents) Export(ctx context.Context) (io.ReadCloser, error) {
 	return response.Body, nil
 }
 
+func (d *documents) ExportTo(ctx context.Context, w io.Writer, params *api.ExportDocumentsParams) (int, error) {
+	if err := validateCollectionName(d.collectionName); err != nil {
+		return 0, err
+	}
+	if params == nil {
+		params = &api.ExportDocumentsParams{}
+	}
+	response, err := d.apiClient.ExportDocuments(ctx, d.collectionName, params)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(response.Body)
+		return 0, &HTTPError{Status: response.StatusCode, Body: body}
+	}
+	counter := &lineCountingWriter{w: w}
+	_, err = io.Copy(counter, response.Body)
+	return counter.count(), err
+}
+
+func (d *documents) Scroll(ctx context.Context, pageSize int) (*ScrollIterator, error) {
+	if pageSize <= 0 {
+		return nil, fmt.Errorf("invalid page size %d", pageSize)
+	}
+	body, err := d.Export(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newScrollIterator(body, pageSize), nil
+}
+
+// lineCountingWriter counts the jsonl lines written through it
+type lineCountingWriter struct {
+	w        io.Writer
+	lines    int
+	lastByte byte
+}
+
+func (c *lineCountingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.lines += bytes.Count(p[:n], []byte{'\n'})
+	if n > 0 {
+		c.lastByte = p[n-1]
+	}
+	return n, err
+}
+
+// count returns the number of lines, including a last line without a trailing newline
+func (c *lineCountingWriter) count() int {
+	if c.lastByte != 0 && c.lastByte != '\n' {
+		return c.lines + 1
+	}
+	return c.lines
+}
+
 func initImportParams(params *api.ImportDocumentsParams) {
 	if params.BatchSize == nil {
 		params.BatchSize = new(int)
@@ -127,20 +497,106 @@ func initImportParams(params *api.ImportDocumentsParams) {
 	}
 }
 
-func (d *documents) Import(ctx context.Context, documents []interface{}, params *api.ImportDocumentsParams) ([]*api.ImportDocumentResponse, error) {
-	if len(documents) == 0 {
+// contextReader stops reading from r once ctx is done, so that a streaming
+// request body is not sent any further after the request is canceled. A read
+// blocked on r returns as soon as ctx is done; r is never closed as it is owned
+// by the caller, the blocked read of r completes in the background.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+	buf []byte
+}
+
+type readResult struct {
+	n   int
+	err error
+}
+
+func (c *contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	// r reads into buf rather than p, as p is reused by the caller once Read returns
+	if cap(c.buf) < len(p) {
+		c.buf = make([]byte, len(p))
+	}
+	buf := c.buf[:len(p)]
+	result := make(chan readResult, 1)
+	go func() {
+		n, err := c.r.Read(buf)
+		result <- readResult{n: n, err: err}
+	}()
+	select {
+	case res := <-result:
+		return copy(p, buf[:res.n]), res.err
+	case <-c.ctx.Done():
+		// buf is abandoned to the pending read, later reads fail before using it
+		c.buf = nil
+		return 0, c.ctx.Err()
+	}
+}
+
+// importRequestBody wraps streaming bodies in a contextReader. In-memory bodies are
+// sent as is, so that the request keeps their length and can be replayed; they are
+// not sent any further once the request context is done anyway.
+func importRequestBody(ctx context.Context, body io.Reader) io.Reader {
+	switch body.(type) {
+	case *bytes.Buffer, *bytes.Reader, *strings.Reader:
+		return body
+	}
+	return &contextReader{ctx: ctx, r: body}
+}
+
+// importBody converts the supported import input types to a JSONL reader
+func importBody(documents any, marshalStructs bool) (io.Reader, error) {
+	switch v := documents.(type) {
+	case nil:
+		return nil, errors.New("documents list is empty")
+	case []byte:
+		if len(v) == 0 {
+			return nil, errors.New("documents list is empty")
+		}
+		return bytes.NewReader(v), nil
+	case io.Reader:
+		return v, nil
+	}
+
+	value := reflect.ValueOf(documents)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil, fmt.Errorf("unsupported documents type %T, expected a slice, []byte or io.Reader", documents)
+	}
+	if value.Len() == 0 {
 		return nil, errors.New("documents list is empty")
 	}
+	// named byte slices, e.g. json.RawMessage, hold JSONL like []byte does
+	if value.Type().Elem().Kind() == reflect.Uint8 {
+		if value.Kind() == reflect.Array {
+			return nil, fmt.Errorf("unsupported documents type %T, expected a slice, []byte or io.Reader", documents)
+		}
+		return bytes.NewReader(value.Bytes()), nil
+	}
 
 	var buf bytes.Buffer
 	jsonEncoder := json.NewEncoder(&buf)
-	for _, doc := range documents {
-		if err := jsonEncoder.Encode(doc); err != nil {
+	for i := 0; i < value.Len(); i++ {
+		document, err := encodeDocument(value.Index(i).Interface(), marshalStructs)
+		if err != nil {
+			return nil, err
+		}
+		if err := jsonEncoder.Encode(document); err != nil {
 			return nil, err
 		}
 	}
+	return &buf, nil
+}
+
+func (d *documents) Import(ctx context.Context, documents any, params *api.ImportDocumentsParams) ([]*api.ImportDocumentResponse, error) {
+	body, err := importBody(documents, d.marshalDocuments)
+	if err != nil {
+		return nil, err
+	}
 
-	response, err := d.ImportJsonl(ctx, &buf, params)
+	response, err := d.ImportJsonl(ctx, body, params)
 	if err != nil {
 		return nil, err
 	}
@@ -159,9 +615,12 @@ func (d *documents) Import(ctx context.Context, documents []interface{}, params
 }
 
 func (d *documents) ImportJsonl(ctx context.Context, body io.Reader, params *api.ImportDocumentsParams) (io.ReadCloser, error) {
+	if err := validateCollectionName(d.collectionName); err != nil {
+		return nil, err
+	}
 	initImportParams(params)
 	response, err := d.apiClient.ImportDocumentsWithBody(ctx,
-		d.collectionName, params, "application/octet-stream", body)
+		d.collectionName, params, "application/octet-stream", importRequestBody(ctx, body))
 	if err != nil {
 		return nil, err
 	}
@@ -172,3 +631,97 @@ func (d *documents) ImportJsonl(ctx context.Context, body io.Reader, params *api
 	}
 	return response.Body, nil
 }
+
+func (d *documents) ImportFromChannel(ctx context.Context, documents <-chan any, params *api.ImportDocumentsParams) (<-chan api.ImportResult, error) {
+	if documents == nil {
+		return nil, errors.New("documents channel is nil")
+	}
+	if err := validateCollectionName(d.collectionName); err != nil {
+		return nil, err
+	}
+	batchParams := api.ImportDocumentsParams{}
+	if params != nil {
+		batchParams = *params
+	}
+	initImportParams(&batchParams)
+	batchSize := *batchParams.BatchSize
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("invalid batch size %d", batchSize)
+	}
+
+	results := make(chan api.ImportResult)
+	send := func(result api.ImportResult) bool {
+		select {
+		case results <- result:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	importBatch := func(batch []any) bool {
+		for len(batch) > 0 {
+			size := batchSize
+			if size > len(batch) {
+				size = len(batch)
+			}
+			responses, err := d.Import(ctx, batch[:size], &batchParams)
+			if errors.Is(err, ErrRequestEntityTooLarge) && size > 1 {
+				batchSize = size / 2
+				continue
+			}
+			if err != nil {
+				for range batch[:size] {
+					if !send(api.ImportResult{Err: err}) {
+						return false
+					}
+				}
+			} else {
+				for _, response := range responses {
+					if !send(api.ImportResult{Response: response}) {
+						return false
+					}
+				}
+			}
+			batch = batch[size:]
+		}
+		return true
+	}
+
+	go func() {
+		defer close(results)
+		batch := make([]any, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case document, ok := <-documents:
+				if !ok {
+					if len(batch) > 0 {
+						importBatch(batch)
+					}
+					return
+				}
+				batch = append(batch, document)
+				if len(batch) == batchSize {
+					if !importBatch(batch) {
+						return
+					}
+					batch = make([]any, 0, batchSize)
+				}
+			}
+		}
+	}()
+	return results, nil
+}
+
+func (d *documents) Validate(ctx context.Context, documents any) ([]DocumentViolation, error) {
+	body, err := importBody(documents, d.marshalDocuments)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := retrieveCollection(ctx, d.apiClient, d.schemaCache, d.collectionName)
+	if err != nil {
+		return nil, err
+	}
+	return validateDocuments(schema.Fields, body)
+}
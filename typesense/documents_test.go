@@ -1,13 +1,17 @@
 package typesense
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/typesense/typesense-go/v2/typesense/api"
@@ -113,6 +117,108 @@ func TestDocumentCreateOnHttpStatusErrorCodeReturnsError(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestDocumentCreateReturnsServerGeneratedID(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/collections/companies/documents", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		var doc map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&doc)
+		assert.NoError(t, err)
+		assert.NotContains(t, doc, "id")
+
+		doc["id"] = "0"
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write(jsonEncode(t, doc))
+	})
+	defer server.Close()
+
+	document := map[string]interface{}{"companyName": "Stark Industries"}
+	result, err := client.Collection("companies").Documents().Create(context.Background(), document)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": "0", "companyName": "Stark Industries"}, result)
+
+	result, err = client.Collection("companies").Documents().Upsert(context.Background(), document)
+	assert.NoError(t, err)
+	assert.Equal(t, "0", result["id"])
+}
+
+type timestampedCompany struct {
+	ID        string    `json:"id"`
+	FoundedAt time.Time `json:"founded_at"`
+}
+
+func (c timestampedCompany) MarshalDocument() (map[string]interface{}, error) {
+	return api.MarshalDocument(struct {
+		ID        string    `json:"id"`
+		FoundedAt time.Time `json:"founded_at"`
+	}(c))
+}
+
+func TestDocumentCreateWithDocumentMarshaler(t *testing.T) {
+	foundedAt := time.Date(1939, 5, 1, 0, 0, 0, 0, time.UTC)
+	expectedDocument := map[string]interface{}{"id": "123", "founded_at": foundedAt.Unix()}
+	mockedResult := map[string]interface{}{"id": "123", "founded_at": float64(foundedAt.Unix())}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	notNill := gomock.Not(gomock.Nil())
+	mockAPIClient.EXPECT().
+		IndexDocumentWithResponse(notNill, "companies", &api.IndexDocumentParams{}, expectedDocument).
+		Return(&api.IndexDocumentResponse{
+			JSON201: &mockedResult,
+		}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	result, err := client.Collection("companies").Documents().Create(context.Background(),
+		timestampedCompany{ID: "123", FoundedAt: foundedAt})
+
+	assert.Nil(t, err)
+	assert.Equal(t, mockedResult, result)
+}
+
+func TestDocumentCreateWithMarshalDocuments(t *testing.T) {
+	foundedAt := time.Date(1939, 5, 1, 0, 0, 0, 0, time.UTC)
+	document := &struct {
+		ID        string    `json:"id"`
+		FoundedAt time.Time `json:"founded_at"`
+	}{ID: "123", FoundedAt: foundedAt}
+	mockedResult := map[string]interface{}{"id": "123", "founded_at": float64(foundedAt.Unix())}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	notNill := gomock.Not(gomock.Nil())
+	mockAPIClient.EXPECT().
+		IndexDocumentWithResponse(notNill, "companies", &api.IndexDocumentParams{}, document).
+		Return(&api.IndexDocumentResponse{
+			JSON201: &mockedResult,
+		}, nil).
+		Times(1)
+	mockAPIClient.EXPECT().
+		IndexDocumentWithResponse(notNill, "companies", &api.IndexDocumentParams{},
+			map[string]interface{}{"id": "123", "founded_at": foundedAt.Unix()}).
+		Return(&api.IndexDocumentResponse{
+			JSON201: &mockedResult,
+		}, nil).
+		Times(1)
+
+	// without the option, structs are sent with their encoding/json encoding
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Collection("companies").Documents().Create(context.Background(), document)
+	assert.Nil(t, err)
+
+	client = NewClient(WithAPIClient(mockAPIClient), WithMarshalDocuments())
+	result, err := client.Collection("companies").Documents().Create(context.Background(), document)
+	assert.Nil(t, err)
+	assert.Equal(t, mockedResult, result)
+}
+
 func TestDocumentUpsert(t *testing.T) {
 	newDocument := createNewDocument()
 	expectedResult := createNewDocumentResponse()
@@ -276,6 +382,182 @@ func TestDocumentsDeleteOnHttpStatusErrorCodeReturnsError(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestDocumentsDeleteWithResult(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r, "/collections/companies/documents?batch_size=100&filter_by=num_employees%3A%3E100", http.MethodDelete)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"num_deleted": 27}`))
+	})
+	defer server.Close()
+
+	filter := &api.DeleteDocumentsParams{FilterBy: pointer.String("num_employees:>100"), BatchSize: pointer.Int(100)}
+	result, err := client.Collection("companies").Documents().DeleteWithResult(context.Background(), filter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &api.DeleteDocumentsResult{NumDeleted: 27}, result)
+}
+
+func TestDocumentsDeleteWithResultOnHttpStatusErrorCodeReturnsError(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "Not Found"}`))
+	})
+	defer server.Close()
+
+	filter := &api.DeleteDocumentsParams{FilterBy: pointer.String("num_employees:>100")}
+	result, err := client.Collection("companies").Documents().DeleteWithResult(context.Background(), filter)
+
+	assert.ErrorContains(t, err, "status: 404")
+	assert.Nil(t, result)
+}
+
+func TestDocumentsDeleteMany(t *testing.T) {
+	ids := make([]string, 250)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i)
+	}
+
+	var filters []string
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/collections/companies/documents", r.URL.Path)
+		filter := r.URL.Query().Get("filter_by")
+		filters = append(filters, filter)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(jsonEncode(t, map[string]int{"num_deleted": strings.Count(filter, ",") + 1}))
+	})
+	defer server.Close()
+
+	numDeleted, err := client.Collection("companies").Documents().DeleteMany(context.Background(), ids)
+	assert.NoError(t, err)
+	assert.Equal(t, 250, numDeleted)
+	assert.Len(t, filters, 3)
+	assert.True(t, strings.HasPrefix(filters[0], "id:[`0`,`1`,"))
+	assert.True(t, strings.HasSuffix(filters[0], ",`99`]"))
+	assert.True(t, strings.HasPrefix(filters[2], "id:[`200`,`201`,"))
+	assert.True(t, strings.HasSuffix(filters[2], ",`249`]"))
+}
+
+func TestDocumentsDeleteManyWithBacktickInIDReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	ids := make([]string, 150)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i)
+	}
+	// the invalid id is in the second chunk, no chunk is deleted
+	ids[120] = "x`] || id:!=[`y"
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	numDeleted, err := client.Collection("companies").Documents().DeleteMany(context.Background(), ids)
+	assert.ErrorIs(t, err, api.ErrInvalidFilter)
+	assert.Equal(t, 0, numDeleted)
+}
+
+func TestDocumentsDeleteManyWithNoIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	numDeleted, err := client.Collection("companies").Documents().DeleteMany(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, numDeleted)
+}
+
+func TestDocumentsDeleteManyOnHttpStatusErrorCodeReturnsDeletedCount(t *testing.T) {
+	ids := make([]string, 150)
+	for i := range ids {
+		ids[i] = "company-" + strconv.Itoa(i)
+	}
+
+	requests := 0
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests > 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("Internal server error"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"num_deleted": 100}`))
+	})
+	defer server.Close()
+
+	numDeleted, err := client.Collection("companies").Documents().DeleteMany(context.Background(), ids)
+	assert.Equal(t, &HTTPError{Status: http.StatusInternalServerError, Body: []byte("Internal server error")}, err)
+	assert.Equal(t, 100, numDeleted)
+}
+
+func TestDocumentsCompareAndSwap(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/collections/companies/documents", r.URL.Path)
+		assert.Equal(t, "id:[`123`] && version:=3", r.URL.Query().Get("filter_by"))
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{"companyName": "Stark Industries", "version": float64(4)}, body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"num_updated": 1}`))
+	})
+	defer server.Close()
+
+	document := map[string]any{"id": "123", "companyName": "Stark Industries", "version": 3}
+	version, err := client.Collection("companies").Documents().CompareAndSwap(context.Background(), "123", "version", 3, document)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), version)
+}
+
+func TestDocumentsCompareAndSwapOnVersionMismatchReturnsConflict(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"num_updated": 0}`))
+	})
+	defer server.Close()
+
+	version, err := client.Collection("companies").Documents().CompareAndSwap(context.Background(), "123", "version", 3,
+		map[string]any{"companyName": "Stark Industries"})
+	assert.ErrorIs(t, err, ErrVersionConflict)
+	assert.Equal(t, int64(0), version)
+}
+
+func TestDocumentsCompareAndSwapValidatesArguments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	documents := client.Collection("companies").Documents()
+	_, err := documents.CompareAndSwap(context.Background(), "123", "", 3, map[string]any{})
+	assert.Error(t, err)
+	_, err = documents.CompareAndSwap(context.Background(), "123", "id", 3, map[string]any{})
+	assert.Error(t, err)
+	_, err = documents.CompareAndSwap(context.Background(), "123", "version", 3, map[string]any{"id": "456"})
+	assert.EqualError(t, err, `document id 456 does not match "123"`)
+}
+
+func TestDocumentsCompareAndSwapWithBacktickInIDReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Collection("companies").Documents().CompareAndSwap(context.Background(),
+		"123`] || id:!=[`123", "version", 3, map[string]any{"country": "USA"})
+	assert.ErrorIs(t, err, api.ErrInvalidFilter)
+}
+
+func TestIdsFilter(t *testing.T) {
+	filter, err := idsFilter([]string{"123", "a,b", "https://example.com/c"})
+	assert.NoError(t, err)
+	assert.Equal(t, "id:[`123`,`a,b`,`https://example.com/c`]", filter)
+
+	_, err = idsFilter([]string{"123", "a`] || id:!=[`x"})
+	assert.ErrorIs(t, err, api.ErrInvalidFilter)
+}
+
 func createDocumentStream() io.ReadCloser {
 	return ioutil.NopCloser(strings.NewReader(`{"id": "125","company_name":"Future Technology","num_employees":1232,"country":"UK"}`))
 }
@@ -306,6 +588,75 @@ func TestDocumentsExport(t *testing.T) {
 	assert.Equal(t, string(expectedBytes), string(resultBytes))
 }
 
+func TestDocumentsExportTo(t *testing.T) {
+	exported := `{"id": "124","company_name":"Stark Industries","num_employees":5215,"country":"USA"}` + "\n" +
+		`{"id": "125","company_name":"Future Technology","num_employees":1232,"country":"UK"}`
+	expectedParams := &api.ExportDocumentsParams{
+		FilterBy:      pointer.String("num_employees:>1000"),
+		IncludeFields: pointer.String("id,company_name"),
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		ExportDocuments(gomock.Not(gomock.Nil()), "companies", expectedParams).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(exported)),
+		}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	var buf bytes.Buffer
+	count, err := client.Collection("companies").Documents().ExportTo(context.Background(), &buf, expectedParams)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, exported, buf.String())
+}
+
+func TestDocumentsExportToWithEmptyCollection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		ExportDocuments(gomock.Not(gomock.Nil()), "companies", &api.ExportDocumentsParams{}).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader("")),
+		}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	var buf bytes.Buffer
+	count, err := client.Collection("companies").Documents().ExportTo(context.Background(), &buf, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+	assert.Empty(t, buf.String())
+}
+
+func TestDocumentsExportToOnHttpStatusErrorCodeReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		ExportDocuments(gomock.Not(gomock.Nil()), "companies", &api.ExportDocumentsParams{}).
+		Return(&http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       ioutil.NopCloser(strings.NewReader("Not Found")),
+		}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	var buf bytes.Buffer
+	_, err := client.Collection("companies").Documents().ExportTo(context.Background(), &buf, nil)
+	assert.Equal(t, &HTTPError{Status: http.StatusNotFound, Body: []byte("Not Found")}, err)
+	assert.Empty(t, buf.String())
+}
+
 func TestDocumentsExportOnApiClientErrorReturnsError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -2,9 +2,15 @@ package typesense
 
 import (
 	"context"
+	"fmt"
 	"time"
 )
 
+// defaultReadinessBackoff polls from 100ms up to every 5s
+var defaultReadinessBackoff = ExponentialBackoff{Initial: 100 * time.Millisecond, Max: 5 * time.Second}
+
+var readinessAfter = time.After // for test stubbing
+
 func (c *Client) Health(ctx context.Context, timeout time.Duration) (bool, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
@@ -17,3 +23,47 @@ func (c *Client) Health(ctx context.Context, timeout time.Duration) (bool, error
 	}
 	return response.JSON200.Ok, nil
 }
+
+// Ping checks that the server is reachable with a request to the health endpoint
+// whose response body is not read. Unlike Health, it succeeds whatever the status of
+// the response, e.g. also while the server is still loading or lagging behind. It
+// returns an error only if no response is received.
+func (c *Client) Ping(ctx context.Context) error {
+	response, err := c.apiClient.Health(ctx)
+	if err != nil {
+		return err
+	}
+	return response.Body.Close()
+}
+
+// WaitUntilReady polls the health endpoint until the server reports that it is
+// healthy, waiting between the checks as determined by backoff. A nil backoff polls
+// with an exponential backoff from 100ms to 5s. It returns an error when ctx is done
+// before the server is ready.
+func (c *Client) WaitUntilReady(ctx context.Context, backoff BackoffStrategy) error {
+	if backoff == nil {
+		backoff = defaultReadinessBackoff
+	}
+	timeout := c.apiConfig.ConnectionTimeout
+	if timeout == 0 {
+		timeout = defaultConnectionTimeout
+	}
+	var lastErr error
+	for attempt := 0; ; attempt++ {
+		ok, err := c.Health(ctx, timeout)
+		if ok {
+			return nil
+		}
+		if err != nil {
+			lastErr = err
+		}
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return fmt.Errorf("server not ready: %w (last error: %v)", ctx.Err(), lastErr)
+			}
+			return fmt.Errorf("server not ready: %w", ctx.Err())
+		case <-readinessAfter(backoff.Backoff(attempt)):
+		}
+	}
+}
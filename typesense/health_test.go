@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -81,3 +82,113 @@ func TestHealthStatusOnHttpStatusErrorCodeReturnsError(t *testing.T) {
 	assert.Error(t, err)
 	assert.False(t, result)
 }
+
+func stubReadinessAfter(t *testing.T) *[]time.Duration {
+	var delays []time.Duration
+	readinessAfter = func(d time.Duration) <-chan time.Time {
+		delays = append(delays, d)
+		ch := make(chan time.Time, 1)
+		ch <- time.Now()
+		return ch
+	}
+	t.Cleanup(func() { readinessAfter = time.After })
+	return &delays
+}
+
+func TestPing(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusServiceUnavailable} {
+		server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+			validateRequestMetadata(t, r, "/health", http.MethodGet)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			w.Write([]byte(`{"ok": false}`))
+		})
+		assert.NoError(t, client.Ping(context.Background()))
+		server.Close()
+	}
+}
+
+func TestPingOnUnreachableServerReturnsError(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	client := NewClient(WithServer(server.URL), WithConnectionTimeout(time.Second))
+	assert.Error(t, client.Ping(context.Background()))
+}
+
+func TestWaitUntilReadyWithIncreasingIntervals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	gomock.InOrder(
+		mockAPIClient.EXPECT().
+			HealthWithResponse(gomock.Not(gomock.Nil())).
+			Return(nil, errors.New("connection refused")).
+			Times(2),
+		mockAPIClient.EXPECT().
+			HealthWithResponse(gomock.Not(gomock.Nil())).
+			Return(&api.HealthResponse{JSON200: &api.HealthStatus{Ok: false}}, nil).
+			Times(2),
+		mockAPIClient.EXPECT().
+			HealthWithResponse(gomock.Not(gomock.Nil())).
+			Return(&api.HealthResponse{JSON200: &api.HealthStatus{Ok: true}}, nil).
+			Times(1),
+	)
+
+	delays := stubReadinessAfter(t)
+	client := NewClient(WithAPIClient(mockAPIClient))
+	err := client.WaitUntilReady(context.Background(),
+		ExponentialBackoff{Initial: 100 * time.Millisecond, Max: 500 * time.Millisecond})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		500 * time.Millisecond,
+	}, *delays)
+}
+
+func TestWaitUntilReadyWithDefaultBackoff(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	gomock.InOrder(
+		mockAPIClient.EXPECT().
+			HealthWithResponse(gomock.Not(gomock.Nil())).
+			Return(nil, errors.New("connection refused")).
+			Times(1),
+		mockAPIClient.EXPECT().
+			HealthWithResponse(gomock.Not(gomock.Nil())).
+			Return(&api.HealthResponse{JSON200: &api.HealthStatus{Ok: true}}, nil).
+			Times(1),
+	)
+
+	delays := stubReadinessAfter(t)
+	client := NewClient(WithAPIClient(mockAPIClient))
+	err := client.WaitUntilReady(context.Background(), nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Duration{100 * time.Millisecond}, *delays)
+}
+
+func TestWaitUntilReadyOnContextDoneReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		HealthWithResponse(gomock.Not(gomock.Nil())).
+		Return(nil, errors.New("connection refused")).
+		AnyTimes()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	client := NewClient(WithAPIClient(mockAPIClient))
+	err := client.WaitUntilReady(ctx, ConstantBackoff(10*time.Millisecond))
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "connection refused")
+}
@@ -3,6 +3,7 @@ package typesense
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"io/ioutil"
@@ -10,6 +11,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/typesense/typesense-go/v2/typesense/api"
@@ -434,3 +436,526 @@ func TestDocumentsImportJsonlWithBatchSizeOnly(t *testing.T) {
 	_, err := client.Collection("companies").Documents().ImportJsonl(context.Background(), importBody, params)
 	assert.Nil(t, err)
 }
+
+func TestDocumentsImportWithRemoteEmbeddingParams(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/collections/companies/documents/import", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		query := r.URL.Query()
+		assert.Equal(t, "upsert", query.Get("action"))
+		assert.Equal(t, "40", query.Get("batch_size"))
+		assert.Equal(t, "100", query.Get("remote_embedding_batch_size"))
+		assert.Equal(t, "3", query.Get("remote_embedding_num_tries"))
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write([]byte(`{"success": true}`))
+	})
+	defer server.Close()
+
+	params := &api.ImportDocumentsParams{
+		Action:                   pointer.String("upsert"),
+		RemoteEmbeddingBatchSize: pointer.Int(100),
+		RemoteEmbeddingNumTries:  pointer.Int(3),
+	}
+	result, err := client.Collection("companies").Documents().Import(context.Background(),
+		[]interface{}{createNewDocument()}, params)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*api.ImportDocumentResponse{{Success: true}}, result)
+}
+
+func TestDocumentsEmplace(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/collections/companies/documents/import", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		query := r.URL.Query()
+		assert.Equal(t, "emplace", query.Get("action"))
+		assert.Equal(t, "100", query.Get("batch_size"))
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write([]byte("{\"success\": true}\n{\"success\": true}"))
+	})
+	defer server.Close()
+
+	params := &api.ImportDocumentsParams{
+		Action:    pointer.String("upsert"),
+		BatchSize: pointer.Int(100),
+	}
+	result, err := client.Collection("companies").Documents().Emplace(context.Background(),
+		[]interface{}{createNewDocument("123"), createNewDocument("125")}, params)
+	assert.NoError(t, err)
+	assert.Equal(t, []*api.ImportDocumentResponse{{Success: true}, {Success: true}}, result)
+	assert.Equal(t, "upsert", *params.Action)
+}
+
+func TestDocumentsImportAcceptedInputTypes(t *testing.T) {
+	type companyDocument struct {
+		ID          string `json:"id"`
+		CompanyName string `json:"companyName"`
+	}
+	expectedBody := `{"companyName":"Stark Industries","id":"123"}` + "\n" +
+		`{"companyName":"Wayne Enterprises","id":"124"}` + "\n"
+	structBody := `{"id":"123","companyName":"Stark Industries"}` + "\n" +
+		`{"id":"124","companyName":"Wayne Enterprises"}` + "\n"
+
+	tests := []struct {
+		name         string
+		documents    any
+		expectedBody string
+	}{
+		{
+			name: "interface slice",
+			documents: []interface{}{
+				map[string]interface{}{"id": "123", "companyName": "Stark Industries"},
+				map[string]interface{}{"id": "124", "companyName": "Wayne Enterprises"},
+			},
+			expectedBody: expectedBody,
+		},
+		{
+			name: "map slice",
+			documents: []map[string]interface{}{
+				{"id": "123", "companyName": "Stark Industries"},
+				{"id": "124", "companyName": "Wayne Enterprises"},
+			},
+			expectedBody: expectedBody,
+		},
+		{
+			name: "struct slice",
+			documents: []companyDocument{
+				{ID: "123", CompanyName: "Stark Industries"},
+				{ID: "124", CompanyName: "Wayne Enterprises"},
+			},
+			expectedBody: structBody,
+		},
+		{
+			name: "struct pointer array",
+			documents: [2]*companyDocument{
+				{ID: "123", CompanyName: "Stark Industries"},
+				{ID: "124", CompanyName: "Wayne Enterprises"},
+			},
+			expectedBody: structBody,
+		},
+		{
+			name:         "jsonl bytes",
+			documents:    []byte(structBody),
+			expectedBody: structBody,
+		},
+		{
+			name:         "jsonl raw message",
+			documents:    json.RawMessage(structBody),
+			expectedBody: structBody,
+		},
+		{
+			name:         "jsonl reader",
+			documents:    strings.NewReader(structBody),
+			expectedBody: structBody,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/collections/companies/documents/import", r.URL.Path)
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedBody, string(body))
+				w.Header().Set("Content-Type", "application/octet-stream")
+				w.Write([]byte("{\"success\": true}\n{\"success\": true}"))
+			})
+			defer server.Close()
+
+			result, err := client.Collection("companies").Documents().Import(context.Background(),
+				tt.documents, &api.ImportDocumentsParams{})
+			assert.NoError(t, err)
+			assert.Equal(t, []*api.ImportDocumentResponse{{Success: true}, {Success: true}}, result)
+		})
+	}
+}
+
+func TestDocumentsImportSendsInMemoryBodiesWithContentLength(t *testing.T) {
+	body := `{"id":"123","companyName":"Stark Industries"}` + "\n"
+	tests := []struct {
+		name      string
+		documents any
+	}{
+		{name: "map slice", documents: []map[string]interface{}{{"id": "123", "companyName": "Stark Industries"}}},
+		{name: "jsonl bytes", documents: []byte(body)},
+		{name: "jsonl bytes reader", documents: bytes.NewReader([]byte(body))},
+		{name: "jsonl string reader", documents: strings.NewReader(body)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+				received, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, int64(len(received)), r.ContentLength)
+				assert.Empty(t, r.TransferEncoding)
+				w.Header().Set("Content-Type", "application/octet-stream")
+				w.Write([]byte("{\"success\": true}"))
+			})
+			defer server.Close()
+
+			_, err := client.Collection("companies").Documents().Import(context.Background(),
+				tt.documents, &api.ImportDocumentsParams{})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestImportRequestBodyKeepsInMemoryBodiesRewindable(t *testing.T) {
+	ctx := context.Background()
+	for _, body := range []io.Reader{bytes.NewBufferString("{}\n"), bytes.NewReader([]byte("{}\n")), strings.NewReader("{}\n")} {
+		req, err := http.NewRequest(http.MethodPost, "http://localhost:8108/collections/companies/documents/import", importRequestBody(ctx, body))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), req.ContentLength)
+		assert.NotNil(t, req.GetBody)
+	}
+
+	pipeReader, _ := io.Pipe()
+	assert.IsType(t, &contextReader{}, importRequestBody(ctx, pipeReader))
+}
+
+func TestDocumentsImportWithUnsupportedInputTypeReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	tests := []struct {
+		name        string
+		documents   any
+		expectedErr string
+	}{
+		{name: "nil", documents: nil, expectedErr: "documents list is empty"},
+		{name: "empty bytes", documents: []byte{}, expectedErr: "documents list is empty"},
+		{name: "empty slice", documents: []map[string]interface{}{}, expectedErr: "documents list is empty"},
+		{name: "string", documents: "{}", expectedErr: "unsupported documents type string"},
+		{name: "single map", documents: map[string]interface{}{"id": "123"}, expectedErr: "unsupported documents type map[string]interface {}"},
+		{name: "empty raw message", documents: json.RawMessage{}, expectedErr: "documents list is empty"},
+		{name: "byte array", documents: [2]byte{'{', '}'}, expectedErr: "unsupported documents type [2]uint8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.Collection("companies").Documents().Import(context.Background(),
+				tt.documents, &api.ImportDocumentsParams{})
+			assert.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestDocumentsImportWithDocumentMarshaler(t *testing.T) {
+	foundedAt := time.Date(2001, 9, 9, 1, 46, 40, 0, time.UTC)
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"founded_at":1000000000,"id":"123"}`+"\n", string(body))
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write([]byte("{\"success\": true}"))
+	})
+	defer server.Close()
+
+	result, err := client.Collection("companies").Documents().Import(context.Background(),
+		[]timestampedCompany{{ID: "123", FoundedAt: foundedAt}}, &api.ImportDocumentsParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*api.ImportDocumentResponse{{Success: true}}, result)
+}
+
+func TestDocumentsImportWithMarshalDocuments(t *testing.T) {
+	type company struct {
+		ID        string    `json:"id"`
+		FoundedAt time.Time `json:"founded_at"`
+	}
+	foundedAt := time.Date(2001, 9, 9, 1, 46, 40, 0, time.UTC)
+	expectedBodies := []string{
+		`{"id":"123","founded_at":"2001-09-09T01:46:40Z"}` + "\n",
+		`{"founded_at":1000000000,"id":"123"}` + "\n",
+	}
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedBodies[0], string(body))
+		expectedBodies = expectedBodies[1:]
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write([]byte("{\"success\": true}"))
+	})
+	defer server.Close()
+
+	documents := []company{{ID: "123", FoundedAt: foundedAt}}
+	_, err := client.Collection("companies").Documents().Import(context.Background(), documents, &api.ImportDocumentsParams{})
+	assert.NoError(t, err)
+
+	client = NewClient(WithServer(server.URL), WithMarshalDocuments())
+	result, err := client.Collection("companies").Documents().Import(context.Background(), documents, &api.ImportDocumentsParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*api.ImportDocumentResponse{{Success: true}}, result)
+	assert.Empty(t, expectedBodies)
+}
+
+func TestDocumentsImportFromChannel(t *testing.T) {
+	expectedParams := &api.ImportDocumentsParams{
+		Action:    pointer.String("upsert"),
+		BatchSize: pointer.Int(2),
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	var batches []int
+	mockAPIClient.EXPECT().
+		ImportDocumentsWithBody(gomock.Not(gomock.Nil()),
+			"companies", expectedParams, "application/octet-stream", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ *api.ImportDocumentsParams, _ string, body io.Reader, _ ...api.RequestEditorFn) (*http.Response, error) {
+			data, err := io.ReadAll(body)
+			assert.NoError(t, err)
+			lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+			batches = append(batches, len(lines))
+			results := make([]string, len(lines))
+			for i := range lines {
+				results[i] = `{"success": true}`
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(strings.Join(results, "\n"))),
+			}, nil
+		}).
+		Times(3)
+
+	documents := make(chan any, 5)
+	for _, id := range []string{"123", "124", "125", "126", "127"} {
+		documents <- createNewDocument(id)
+	}
+	close(documents)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	results, err := client.Collection("companies").Documents().ImportFromChannel(context.Background(), documents,
+		&api.ImportDocumentsParams{Action: pointer.String("upsert"), BatchSize: pointer.Int(2)})
+	assert.NoError(t, err)
+
+	var received []api.ImportResult
+	for result := range results {
+		received = append(received, result)
+	}
+	assert.Len(t, received, 5)
+	for _, result := range received {
+		assert.NoError(t, result.Err)
+		assert.Equal(t, &api.ImportDocumentResponse{Success: true}, result.Response)
+	}
+	assert.Equal(t, []int{2, 2, 1}, batches)
+}
+
+func TestDocumentsImportFromChannelHalvesBatchOnRequestEntityTooLarge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	var batches []int
+	mockAPIClient.EXPECT().
+		ImportDocumentsWithBody(gomock.Not(gomock.Nil()),
+			"companies", gomock.Any(), "application/octet-stream", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ *api.ImportDocumentsParams, _ string, body io.Reader, _ ...api.RequestEditorFn) (*http.Response, error) {
+			data, err := io.ReadAll(body)
+			assert.NoError(t, err)
+			lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+			batches = append(batches, len(lines))
+			if len(lines) > 2 {
+				return &http.Response{
+					StatusCode: http.StatusRequestEntityTooLarge,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"message": "Request body too large"}`)),
+				}, nil
+			}
+			results := make([]string, len(lines))
+			for i := range lines {
+				results[i] = `{"success": true}`
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(strings.Join(results, "\n"))),
+			}, nil
+		}).
+		Times(4)
+
+	documents := make(chan any, 5)
+	for _, id := range []string{"123", "124", "125", "126", "127"} {
+		documents <- createNewDocument(id)
+	}
+	close(documents)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	results, err := client.Collection("companies").Documents().ImportFromChannel(context.Background(), documents,
+		&api.ImportDocumentsParams{BatchSize: pointer.Int(4)})
+	assert.NoError(t, err)
+
+	var received []api.ImportResult
+	for result := range results {
+		received = append(received, result)
+	}
+	assert.Len(t, received, 5)
+	for _, result := range received {
+		assert.NoError(t, result.Err)
+		assert.Equal(t, &api.ImportDocumentResponse{Success: true}, result.Response)
+	}
+	assert.Equal(t, []int{4, 2, 2, 1}, batches)
+}
+
+func TestDocumentsImportFromChannelWithSingleDocumentTooLargeReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		ImportDocumentsWithBody(gomock.Not(gomock.Nil()),
+			"companies", gomock.Any(), "application/octet-stream", gomock.Any()).
+		Return(&http.Response{
+			StatusCode: http.StatusRequestEntityTooLarge,
+			Body:       ioutil.NopCloser(strings.NewReader("Request Entity Too Large")),
+		}, nil).
+		Times(2)
+
+	documents := make(chan any, 2)
+	documents <- createNewDocument("123")
+	documents <- createNewDocument("124")
+	close(documents)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	results, err := client.Collection("companies").Documents().ImportFromChannel(context.Background(), documents,
+		&api.ImportDocumentsParams{BatchSize: pointer.Int(1)})
+	assert.NoError(t, err)
+
+	var received []api.ImportResult
+	for result := range results {
+		received = append(received, result)
+	}
+	assert.Len(t, received, 2)
+	for _, result := range received {
+		assert.ErrorIs(t, result.Err, ErrRequestEntityTooLarge)
+	}
+}
+
+func TestDocumentsImportFromChannelOnApiClientErrorReturnsErrorPerDocument(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		ImportDocumentsWithBody(gomock.Not(gomock.Nil()),
+			"companies", gomock.Any(), "application/octet-stream", gomock.Any()).
+		Return(nil, errors.New("failed request")).
+		Times(1)
+
+	documents := make(chan any, 2)
+	documents <- createNewDocument("123")
+	documents <- createNewDocument("124")
+	close(documents)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	results, err := client.Collection("companies").Documents().ImportFromChannel(context.Background(), documents, nil)
+	assert.NoError(t, err)
+
+	var received []api.ImportResult
+	for result := range results {
+		received = append(received, result)
+	}
+	assert.Equal(t, []api.ImportResult{
+		{Err: errors.New("failed request")},
+		{Err: errors.New("failed request")},
+	}, received)
+}
+
+func TestDocumentsImportFromChannelStopsOnContextCancellation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	documents := make(chan any)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	results, err := client.Collection("companies").Documents().ImportFromChannel(ctx, documents, nil)
+	assert.NoError(t, err)
+
+	documents <- createNewDocument("123")
+	cancel()
+
+	select {
+	case _, ok := <-results:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("results channel not closed after context cancellation")
+	}
+}
+
+func TestDocumentsImportFromChannelWithNilChannelReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Collection("companies").Documents().ImportFromChannel(context.Background(), nil, nil)
+	assert.EqualError(t, err, "documents channel is nil")
+}
+
+func TestDocumentsImportJsonlCanceledMidStream(t *testing.T) {
+	firstChunk := []byte(`{"id": "123", "company_name": "Stark Industries"}` + "\n")
+	received := make(chan int, 1)
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		buf := make([]byte, len(firstChunk))
+		n, _ := io.ReadFull(r.Body, buf)
+		received <- n
+		// the rest of the body never arrives as the upload is aborted
+		rest, _ := io.ReadAll(r.Body)
+		assert.Empty(t, rest)
+	})
+	defer server.Close()
+
+	pipeReader, bodyWriter := io.Pipe()
+	body := &closeRecordingReader{Reader: pipeReader}
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		bodyWriter.Write(firstChunk)
+		<-received
+		cancel()
+	}()
+
+	start := time.Now()
+	_, err := client.Collection("companies").Documents().ImportJsonl(ctx, body, &api.ImportDocumentsParams{})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	// the body is owned by the caller and is not closed by the client
+	assert.Equal(t, 0, body.closed)
+	assert.NoError(t, pipeReader.Close())
+}
+
+func TestContextReaderStopsReadingWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	reader := &contextReader{ctx: ctx, r: strings.NewReader("first line\nsecond line\n")}
+
+	buf := make([]byte, 11)
+	n, err := reader.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "first line\n", string(buf[:n]))
+
+	cancel()
+	n, err = reader.Read(buf)
+	assert.Equal(t, 0, n)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestContextReaderUnblocksReadWhenContextIsDone(t *testing.T) {
+	pipeReader, pipeWriter := io.Pipe()
+	body := &closeRecordingReader{Reader: pipeReader}
+	ctx, cancel := context.WithCancel(context.Background())
+	reader := &contextReader{ctx: ctx, r: body}
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+	n, err := reader.Read(make([]byte, 16))
+	assert.Equal(t, 0, n)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, body.closed)
+
+	// the pending read still completes once data arrives
+	_, err = pipeWriter.Write([]byte("{}\n"))
+	assert.NoError(t, err)
+	assert.NoError(t, pipeReader.Close())
+}
@@ -6,17 +6,32 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/typesense/typesense-go/v2/typesense/api"
 )
 
 type KeysInterface interface {
 	Create(context.Context, *api.ApiKeySchema) (*api.ApiKey, error)
+	// CreateBatch creates the keys one at a time. The returned keys and errors
+	// are aligned with schemas: for each schema either the created key, including
+	// its one-time value, or the error of its creation is set.
+	CreateBatch(ctx context.Context, schemas []api.ApiKeySchema) ([]*api.ApiKey, []error)
 	Retrieve(context.Context) ([]*api.ApiKey, error)
 	GenerateScopedSearchKey(searchKey string, params map[string]interface{}) (string, error)
+	// FindByPrefix returns the first key whose value prefix or description starts with prefix
+	FindByPrefix(ctx context.Context, prefix string) (*api.ApiKey, error)
 }
 
+// ErrKeyNotFound is returned by FindByPrefix when no key matches
+var ErrKeyNotFound = errors.New("api key not found")
+
+// scopedKeyParamsOffset is the length of the base64 HMAC digest and the search key
+// prefix that precede the embedded params in a scoped search key
+const scopedKeyParamsOffset = 44 + 4
+
 type keys struct {
 	apiClient APIClientInterface
 }
@@ -33,6 +48,15 @@ func (k *keys) Create(ctx context.Context, key *api.ApiKeySchema) (*api.ApiKey,
 	return response.JSON201, nil
 }
 
+func (k *keys) CreateBatch(ctx context.Context, schemas []api.ApiKeySchema) ([]*api.ApiKey, []error) {
+	createdKeys := make([]*api.ApiKey, len(schemas))
+	errs := make([]error, len(schemas))
+	for i := range schemas {
+		createdKeys[i], errs[i] = k.Create(ctx, &schemas[i])
+	}
+	return createdKeys, errs
+}
+
 func (k *keys) Retrieve(ctx context.Context) ([]*api.ApiKey, error) {
 	response, err := k.apiClient.GetKeysWithResponse(ctx)
 	if err != nil {
@@ -57,3 +81,33 @@ func (k *keys) GenerateScopedSearchKey(searchKey string, params map[string]inter
 	rawScopedKey := fmt.Sprintf("%s%s%s", digest, searchKey[0:4], paramsStr)
 	return base64.StdEncoding.EncodeToString([]byte(rawScopedKey)), nil
 }
+
+// scopedSearchKeyParams returns the search parameters embedded in a key generated
+// by GenerateScopedSearchKey. It returns false when the key is not a scoped search key.
+func scopedSearchKeyParams(scopedKey string) (map[string]interface{}, bool) {
+	rawScopedKey, err := base64.StdEncoding.DecodeString(scopedKey)
+	if err != nil || len(rawScopedKey) <= scopedKeyParamsOffset {
+		return nil, false
+	}
+	var params map[string]interface{}
+	if err := json.Unmarshal(rawScopedKey[scopedKeyParamsOffset:], &params); err != nil {
+		return nil, false
+	}
+	return params, true
+}
+
+func (k *keys) FindByPrefix(ctx context.Context, prefix string) (*api.ApiKey, error) {
+	apiKeys, err := k.Retrieve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range apiKeys {
+		if key.ValuePrefix != nil && strings.HasPrefix(*key.ValuePrefix, prefix) {
+			return key, nil
+		}
+		if strings.HasPrefix(key.Description, prefix) {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no key with prefix %q", ErrKeyNotFound, prefix)
+}
@@ -99,6 +99,50 @@ func TestKeyCreateOnHttpStatusErrorCodeReturnsError(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestKeysCreateBatch(t *testing.T) {
+	firstSchema := createNewKeySchema()
+	secondSchema := createNewKeySchema()
+	secondSchema.Description = "Tenant key."
+	thirdSchema := createNewKeySchema()
+	thirdSchema.Description = "Failing key."
+	firstKey := &api.ApiKey{Id: pointer.Int64(1), Value: pointer.String("k8pX5hD0793d8YQC5aD1aEPd7VleSuGP")}
+	secondKey := &api.ApiKey{Id: pointer.Int64(2), Value: pointer.String("9kCNHNDXmqRbDeKpN3mrLmgXy3xvJ7Eu")}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	notNil := gomock.Not(gomock.Nil())
+	gomock.InOrder(
+		mockAPIClient.EXPECT().
+			CreateKeyWithResponse(notNil, api.CreateKeyJSONRequestBody(*firstSchema)).
+			Return(&api.CreateKeyResponse{JSON201: firstKey}, nil),
+		mockAPIClient.EXPECT().
+			CreateKeyWithResponse(notNil, api.CreateKeyJSONRequestBody(*thirdSchema)).
+			Return(&api.CreateKeyResponse{
+				HTTPResponse: &http.Response{
+					StatusCode: 400,
+				},
+				Body: []byte(`{"message": "Bad request."}`),
+			}, nil),
+		mockAPIClient.EXPECT().
+			CreateKeyWithResponse(notNil, api.CreateKeyJSONRequestBody(*secondSchema)).
+			Return(&api.CreateKeyResponse{JSON201: secondKey}, nil),
+	)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	result, errs := client.Keys().CreateBatch(context.Background(),
+		[]api.ApiKeySchema{*firstSchema, *thirdSchema, *secondSchema})
+
+	assert.Equal(t, []*api.ApiKey{firstKey, nil, secondKey}, result)
+	assert.Len(t, errs, 3)
+	assert.Nil(t, errs[0])
+	assert.Equal(t, &HTTPError{Status: 400, Body: []byte(`{"message": "Bad request."}`)}, errs[1])
+	assert.Nil(t, errs[2])
+	assert.Equal(t, "k8pX5hD0793d8YQC5aD1aEPd7VleSuGP", *result[0].Value)
+	assert.Equal(t, "9kCNHNDXmqRbDeKpN3mrLmgXy3xvJ7Eu", *result[2].Value)
+}
+
 func TestKeysRetrieve(t *testing.T) {
 	expectedResult := []*api.ApiKey{
 		createNewKey(1),
@@ -175,3 +219,76 @@ func TestKeysGenerateScopedSearchKey(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, scopedKey, scopedSearchKey)
 }
+
+func TestKeysFindByPrefix(t *testing.T) {
+	mockedKeys := []*api.ApiKey{
+		{Id: pointer.Int64(1), ValuePrefix: pointer.String("k8pX"), Description: "Admin key."},
+		{Id: pointer.Int64(2), ValuePrefix: pointer.String("Aq7b"), Description: "Search-only key for tenant 42."},
+		{Id: pointer.Int64(3), ValuePrefix: pointer.String("Zz01"), Description: "Search-only key for tenant 43."},
+	}
+	tests := []struct {
+		name       string
+		prefix     string
+		expectedID int64
+	}{
+		{name: "value prefix", prefix: "Aq7b", expectedID: 2},
+		{name: "partial value prefix", prefix: "Zz", expectedID: 3},
+		{name: "description", prefix: "Search-only key for tenant 43", expectedID: 3},
+		{name: "first match wins", prefix: "Search-only", expectedID: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+			mockAPIClient.EXPECT().
+				GetKeysWithResponse(gomock.Not(gomock.Nil())).
+				Return(&api.GetKeysResponse{
+					JSON200: &api.ApiKeysResponse{Keys: mockedKeys},
+				}, nil).
+				Times(1)
+
+			client := NewClient(WithAPIClient(mockAPIClient))
+			result, err := client.Keys().FindByPrefix(context.Background(), tt.prefix)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedID, *result.Id)
+		})
+	}
+}
+
+func TestKeysFindByPrefixWithoutMatchReturnsNotFoundError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		GetKeysWithResponse(gomock.Not(gomock.Nil())).
+		Return(&api.GetKeysResponse{
+			JSON200: &api.ApiKeysResponse{
+				Keys: []*api.ApiKey{{Id: pointer.Int64(1), ValuePrefix: pointer.String("k8pX"), Description: "Admin key."}},
+			},
+		}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	result, err := client.Keys().FindByPrefix(context.Background(), "Aq7b")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+	assert.Nil(t, result)
+}
+
+func TestKeysFindByPrefixOnApiClientErrorReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		GetKeysWithResponse(gomock.Not(gomock.Nil())).
+		Return(nil, errors.New("failed request")).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Keys().FindByPrefix(context.Background(), "Aq7b")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrKeyNotFound)
+}
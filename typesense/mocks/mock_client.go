@@ -42,6 +42,46 @@ func (m *MockAPIClientInterface) EXPECT() *MockAPIClientInterfaceMockRecorder {
 	return m.recorder
 }
 
+// ClearCache mocks base method.
+func (m *MockAPIClientInterface) ClearCache(ctx context.Context, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ClearCache", varargs...)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearCache indicates an expected call of ClearCache.
+func (mr *MockAPIClientInterfaceMockRecorder) ClearCache(ctx any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearCache", reflect.TypeOf((*MockAPIClientInterface)(nil).ClearCache), varargs...)
+}
+
+// ClearCacheWithResponse mocks base method.
+func (m *MockAPIClientInterface) ClearCacheWithResponse(ctx context.Context, reqEditors ...api.RequestEditorFn) (*api.ClearCacheResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range reqEditors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ClearCacheWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.ClearCacheResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearCacheWithResponse indicates an expected call of ClearCacheWithResponse.
+func (mr *MockAPIClientInterfaceMockRecorder) ClearCacheWithResponse(ctx any, reqEditors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, reqEditors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearCacheWithResponse", reflect.TypeOf((*MockAPIClientInterface)(nil).ClearCacheWithResponse), varargs...)
+}
+
 // CreateAnalyticsRule mocks base method.
 func (m *MockAPIClientInterface) CreateAnalyticsRule(ctx context.Context, body api.CreateAnalyticsRuleJSONRequestBody, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -923,9 +963,9 @@ func (mr *MockAPIClientInterfaceMockRecorder) GetCollectionsWithResponse(ctx any
 }
 
 // GetDocument mocks base method.
-func (m *MockAPIClientInterface) GetDocument(ctx context.Context, collectionName, documentId string, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+func (m *MockAPIClientInterface) GetDocument(ctx context.Context, collectionName, documentId string, params *api.GetDocumentParams, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, collectionName, documentId}
+	varargs := []any{ctx, collectionName, documentId, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
@@ -936,16 +976,16 @@ func (m *MockAPIClientInterface) GetDocument(ctx context.Context, collectionName
 }
 
 // GetDocument indicates an expected call of GetDocument.
-func (mr *MockAPIClientInterfaceMockRecorder) GetDocument(ctx, collectionName, documentId any, reqEditors ...any) *gomock.Call {
+func (mr *MockAPIClientInterfaceMockRecorder) GetDocument(ctx, collectionName, documentId, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, collectionName, documentId}, reqEditors...)
+	varargs := append([]any{ctx, collectionName, documentId, params}, reqEditors...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocument", reflect.TypeOf((*MockAPIClientInterface)(nil).GetDocument), varargs...)
 }
 
 // GetDocumentWithResponse mocks base method.
-func (m *MockAPIClientInterface) GetDocumentWithResponse(ctx context.Context, collectionName, documentId string, reqEditors ...api.RequestEditorFn) (*api.GetDocumentResponse, error) {
+func (m *MockAPIClientInterface) GetDocumentWithResponse(ctx context.Context, collectionName, documentId string, params *api.GetDocumentParams, reqEditors ...api.RequestEditorFn) (*api.GetDocumentResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, collectionName, documentId}
+	varargs := []any{ctx, collectionName, documentId, params}
 	for _, a := range reqEditors {
 		varargs = append(varargs, a)
 	}
@@ -956,9 +996,9 @@ func (m *MockAPIClientInterface) GetDocumentWithResponse(ctx context.Context, co
 }
 
 // GetDocumentWithResponse indicates an expected call of GetDocumentWithResponse.
-func (mr *MockAPIClientInterfaceMockRecorder) GetDocumentWithResponse(ctx, collectionName, documentId any, reqEditors ...any) *gomock.Call {
+func (mr *MockAPIClientInterfaceMockRecorder) GetDocumentWithResponse(ctx, collectionName, documentId, params any, reqEditors ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, collectionName, documentId}, reqEditors...)
+	varargs := append([]any{ctx, collectionName, documentId, params}, reqEditors...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocumentWithResponse", reflect.TypeOf((*MockAPIClientInterface)(nil).GetDocumentWithResponse), varargs...)
 }
 
@@ -4,7 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/typesense/typesense-go/v2/typesense/api"
 )
@@ -12,6 +17,22 @@ import (
 type MultiSearchInterface interface {
 	Perform(ctx context.Context, commonSearchParams *api.MultiSearchParams, searchParams api.MultiSearchSearchesParameter) (*api.MultiSearchResult, error)
 	PerformWithContentType(ctx context.Context, commonSearchParams *api.MultiSearchParams, searchParams api.MultiSearchSearchesParameter, contentType string) (*api.MultiSearchResponse, error)
+	// PerformWithScopedKey performs the searches with the scopedKey instead of the client API key.
+	// Params that conflict with the params embedded in a scoped search key are rejected with
+	// ErrScopedKeyConflict before sending the request.
+	PerformWithScopedKey(ctx context.Context, scopedKey string, commonSearchParams *api.MultiSearchParams, searchParams api.MultiSearchSearchesParameter) (*api.MultiSearchResult, error)
+}
+
+// ErrScopedKeyConflict is returned when search params conflict with the params
+// embedded in a scoped search key
+var ErrScopedKeyConflict = errors.New("search parameters conflict with the scoped search key")
+
+// scopedKeyCompatibleParams can be set in a search regardless of the scoped key:
+// filter_by is combined with the embedded filter and the others only apply to the key
+var scopedKeyCompatibleParams = map[string]bool{
+	"filter_by":            true,
+	"expires_at":           true,
+	"limit_multi_searches": true,
 }
 
 type multiSearch struct {
@@ -29,6 +50,73 @@ func (m *multiSearch) Perform(ctx context.Context, commonSearchParams *api.Multi
 	return response.JSON200, nil
 }
 
+func (m *multiSearch) PerformWithScopedKey(ctx context.Context, scopedKey string, commonSearchParams *api.MultiSearchParams, searchParams api.MultiSearchSearchesParameter) (*api.MultiSearchResult, error) {
+	if err := validateScopedKeySearchParams(scopedKey, commonSearchParams, searchParams); err != nil {
+		return nil, err
+	}
+	return m.Perform(api.ContextWithAPIKey(ctx, scopedKey), commonSearchParams, searchParams)
+}
+
+// validateScopedKeySearchParams checks that the search params do not set params embedded
+// in the scoped key to other values. Keys that are not scoped search keys are not checked.
+func validateScopedKeySearchParams(scopedKey string, commonSearchParams *api.MultiSearchParams, searchParams api.MultiSearchSearchesParameter) error {
+	embeddedParams, ok := scopedSearchKeyParams(scopedKey)
+	if !ok {
+		return nil
+	}
+	names := make([]string, 0, len(embeddedParams))
+	for name := range embeddedParams {
+		if !scopedKeyCompatibleParams[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var conflicts []string
+	checkParams := func(params interface{}, location string) error {
+		requestParams, err := jsonParams(params)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			value, ok := requestParams[name]
+			if !ok || reflect.DeepEqual(value, embeddedParams[name]) {
+				continue
+			}
+			conflicts = append(conflicts, fmt.Sprintf("%s (%s)", name, location))
+		}
+		return nil
+	}
+
+	if commonSearchParams != nil {
+		if err := checkParams(commonSearchParams, "common params"); err != nil {
+			return err
+		}
+	}
+	for i := range searchParams.Searches {
+		if err := checkParams(searchParams.Searches[i], fmt.Sprintf("search %d", i)); err != nil {
+			return err
+		}
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("%w: %s", ErrScopedKeyConflict, strings.Join(conflicts, ", "))
+	}
+	return nil
+}
+
+// jsonParams converts params into the map of their JSON encoding
+func jsonParams(params interface{}) (map[string]interface{}, error) {
+	buf, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(buf, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (m *multiSearch) PerformWithContentType(ctx context.Context, commonSearchParams *api.MultiSearchParams, searchParams api.MultiSearchSearchesParameter, contentType string) (*api.MultiSearchResponse, error) {
 	body := api.MultiSearchJSONRequestBody(searchParams)
 	var requestReader io.Reader
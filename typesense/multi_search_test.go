@@ -206,6 +206,21 @@ func TestMultiSearchResultDeserialization(t *testing.T) {
 	assert.Equal(t, expected, result)
 }
 
+func TestMultiSearchResultTotalSearchTimeMs(t *testing.T) {
+	inputJSON := `{
+		"results": [
+			{"found": 1, "search_time_ms": 3, "hits": []},
+			{"code": 404, "error": "Could not find a field named ` + "`missing`" + ` in the schema."},
+			{"found": 0, "search_time_ms": 12, "hits": []}
+		]
+	}`
+	result := &api.MultiSearchResult{}
+	err := json.Unmarshal([]byte(inputJSON), result)
+	assert.NoError(t, err)
+	assert.Equal(t, 15, result.TotalSearchTimeMs())
+	assert.Equal(t, 0, (&api.MultiSearchResult{}).TotalSearchTimeMs())
+}
+
 func TestMultiSearch(t *testing.T) {
 	expectedParams := newMultiSearchParams()
 	expectedResult := newMultiSearchResult()
@@ -293,3 +308,419 @@ func TestMultiSearchOnApiClientError(t *testing.T) {
 	_, err := client.MultiSearch.Perform(context.Background(), params, newMultiSearchBodyParams())
 	assert.NotNil(t, err)
 }
+
+func TestMultiSearchWithSynonymParams(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "false", r.URL.Query().Get("enable_synonyms"))
+
+		var body map[string][]map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		assert.NoError(t, err)
+		assert.Equal(t, true, body["searches"][0]["synonym_prefix"])
+		assert.Equal(t, float64(2), body["searches"][0]["synonym_num_typos"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": []}`))
+	})
+	defer server.Close()
+
+	_, err := client.MultiSearch.Perform(context.Background(),
+		&api.MultiSearchParams{EnableSynonyms: pointer.False()},
+		api.MultiSearchSearchesParameter{
+			Searches: []api.MultiSearchCollectionParameters{
+				{
+					Collection:      "companies",
+					Q:               pointer.String("text"),
+					SynonymPrefix:   pointer.True(),
+					SynonymNumTypos: pointer.Int(2),
+				},
+			},
+		})
+	assert.NoError(t, err)
+}
+
+func TestMultiSearchWithOverrideParams(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("filter_curated_hits"))
+
+		var body map[string][]map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		assert.NoError(t, err)
+		assert.Equal(t, false, body["searches"][0]["enable_overrides"])
+		assert.Equal(t, false, body["searches"][0]["filter_curated_hits"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": []}`))
+	})
+	defer server.Close()
+
+	_, err := client.MultiSearch.Perform(context.Background(),
+		&api.MultiSearchParams{FilterCuratedHits: pointer.True()},
+		api.MultiSearchSearchesParameter{
+			Searches: []api.MultiSearchCollectionParameters{
+				{
+					Collection:        "companies",
+					Q:                 pointer.String("text"),
+					EnableOverrides:   pointer.False(),
+					FilterCuratedHits: pointer.False(),
+				},
+			},
+		})
+	assert.NoError(t, err)
+}
+
+func TestMultiSearchWithRemoteEmbeddingParams(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "5000", r.URL.Query().Get("remote_embedding_timeout_ms"))
+		assert.Equal(t, "3", r.URL.Query().Get("remote_embedding_num_tries"))
+
+		var body map[string][]map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		assert.NoError(t, err)
+		assert.Equal(t, float64(2000), body["searches"][0]["remote_embedding_timeout_ms"])
+		assert.Equal(t, float64(1), body["searches"][0]["remote_embedding_num_tries"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": []}`))
+	})
+	defer server.Close()
+
+	_, err := client.MultiSearch.Perform(context.Background(),
+		&api.MultiSearchParams{
+			RemoteEmbeddingTimeoutMs: pointer.Int(5000),
+			RemoteEmbeddingNumTries:  pointer.Int(3),
+		},
+		api.MultiSearchSearchesParameter{
+			Searches: []api.MultiSearchCollectionParameters{
+				{
+					Collection:               "companies",
+					Q:                        pointer.String("text"),
+					RemoteEmbeddingTimeoutMs: pointer.Int(2000),
+					RemoteEmbeddingNumTries:  pointer.Int(1),
+				},
+			},
+		})
+	assert.NoError(t, err)
+}
+
+func TestMultiSearchWithStopwords(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "stopword_set1", r.URL.Query().Get("stopwords"))
+
+		var body map[string][]map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		assert.NoError(t, err)
+		assert.Equal(t, "stopword_set2", body["searches"][0]["stopwords"])
+		assert.NotContains(t, body["searches"][1], "stopwords")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": []}`))
+	})
+	defer server.Close()
+
+	_, err := client.MultiSearch.Perform(context.Background(),
+		&api.MultiSearchParams{
+			Stopwords: pointer.String("stopword_set1"),
+		},
+		api.MultiSearchSearchesParameter{
+			Searches: []api.MultiSearchCollectionParameters{
+				{
+					Collection: "companies",
+					Q:          pointer.String("text"),
+					Stopwords:  pointer.String("stopword_set2"),
+				},
+				{
+					Collection: "products",
+					Q:          pointer.String("text"),
+				},
+			},
+		})
+	assert.NoError(t, err)
+}
+
+func TestMultiSearchWithDropTokensModeAndHybridParams(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "left_to_right", r.URL.Query().Get("drop_tokens_mode"))
+		assert.Equal(t, "true", r.URL.Query().Get("rerank_hybrid_matches"))
+
+		var body map[string][]map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		assert.NoError(t, err)
+		assert.Equal(t, "right_to_left", body["searches"][0]["drop_tokens_mode"])
+		assert.Equal(t, false, body["searches"][0]["rerank_hybrid_matches"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": []}`))
+	})
+	defer server.Close()
+
+	_, err := client.MultiSearch.Perform(context.Background(),
+		&api.MultiSearchParams{
+			DropTokensMode:      pointer.String("left_to_right"),
+			RerankHybridMatches: pointer.True(),
+		},
+		api.MultiSearchSearchesParameter{
+			Searches: []api.MultiSearchCollectionParameters{
+				{
+					Collection:          "companies",
+					Q:                   pointer.String("text"),
+					DropTokensMode:      pointer.String("right_to_left"),
+					RerankHybridMatches: pointer.False(),
+				},
+			},
+		})
+	assert.NoError(t, err)
+}
+
+func TestMultiSearchWithTypoTokenParams(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "false", r.URL.Query().Get("enable_typos_for_alpha_numerical_tokens"))
+
+		var body map[string][]map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		assert.NoError(t, err)
+		assert.Equal(t, false, body["searches"][0]["enable_typos_for_numerical_tokens"])
+		assert.Equal(t, true, body["searches"][0]["enable_typos_for_alpha_numerical_tokens"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": []}`))
+	})
+	defer server.Close()
+
+	_, err := client.MultiSearch.Perform(context.Background(),
+		&api.MultiSearchParams{
+			EnableTyposForAlphaNumericalTokens: pointer.False(),
+		},
+		api.MultiSearchSearchesParameter{
+			Searches: []api.MultiSearchCollectionParameters{
+				{
+					Collection:                         "products",
+					Q:                                  pointer.String("XK-2000"),
+					EnableTyposForNumericalTokens:      pointer.False(),
+					EnableTyposForAlphaNumericalTokens: pointer.True(),
+				},
+			},
+		})
+	assert.NoError(t, err)
+}
+
+func TestMultiSearchWithEnableLazyFilter(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("enable_lazy_filter"))
+
+		var body map[string][]map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		assert.NoError(t, err)
+		assert.Equal(t, false, body["searches"][0]["enable_lazy_filter"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": []}`))
+	})
+	defer server.Close()
+
+	_, err := client.MultiSearch.Perform(context.Background(),
+		&api.MultiSearchParams{
+			EnableLazyFilter: pointer.True(),
+		},
+		api.MultiSearchSearchesParameter{
+			Searches: []api.MultiSearchCollectionParameters{
+				{
+					Collection:       "companies",
+					Q:                pointer.String("*"),
+					FilterBy:         pointer.String("num_employees:>100"),
+					EnableLazyFilter: pointer.False(),
+				},
+			},
+		})
+	assert.NoError(t, err)
+}
+
+func TestMultiSearchWithOverrideTagsAndSynonymSets(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "black_friday", r.URL.Query().Get("override_tags"))
+		assert.Equal(t, "electronics", r.URL.Query().Get("synonym_sets"))
+
+		var body map[string][]map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		assert.NoError(t, err)
+		assert.Equal(t, "mobile", body["searches"][0]["override_tags"])
+		assert.Equal(t, "electronics,brands", body["searches"][0]["synonym_sets"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": []}`))
+	})
+	defer server.Close()
+
+	_, err := client.MultiSearch.Perform(context.Background(),
+		&api.MultiSearchParams{
+			OverrideTags: pointer.String("black_friday"),
+			SynonymSets:  pointer.String("electronics"),
+		},
+		api.MultiSearchSearchesParameter{
+			Searches: []api.MultiSearchCollectionParameters{
+				{
+					Collection:   "products",
+					Q:            pointer.String("phone"),
+					OverrideTags: pointer.String("mobile"),
+					SynonymSets:  pointer.String("electronics,brands"),
+				},
+			},
+		})
+	assert.NoError(t, err)
+}
+
+func TestMultiSearchWithNlQuery(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "gemini-model", r.URL.Query().Get("nl_model_id"))
+
+		var body map[string][]map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		assert.NoError(t, err)
+		assert.Equal(t, true, body["searches"][0]["nl_query"])
+		assert.Equal(t, "openai-model", body["searches"][0]["nl_model_id"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": [{"found": 0, "hits": [], "parsed_nl_query": {"parse_time_ms": 5}}]}`))
+	})
+	defer server.Close()
+
+	result, err := client.MultiSearch.Perform(context.Background(),
+		&api.MultiSearchParams{
+			NlModelId: pointer.String("gemini-model"),
+		},
+		api.MultiSearchSearchesParameter{
+			Searches: []api.MultiSearchCollectionParameters{
+				{
+					Collection: "products",
+					Q:          pointer.String("cheap running shoes"),
+					NlQuery:    pointer.True(),
+					NlModelId:  pointer.String("openai-model"),
+				},
+			},
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, pointer.Int(5), result.Results[0].ParsedNlQuery.ParseTimeMs)
+}
+
+func TestMultiSearchWithConversationParams(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("conversation"))
+		assert.Equal(t, "conv-model-1", r.URL.Query().Get("conversation_model_id"))
+		assert.Equal(t, "conv-123", r.URL.Query().Get("conversation_id"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"results": [],
+			"conversation": {
+			  "answer": "Here are some action series.",
+			  "conversation_history": [],
+			  "conversation_id": "conv-123",
+			  "query": "action series"
+			}
+		  }`))
+	})
+	defer server.Close()
+
+	result, err := client.MultiSearch.Perform(context.Background(),
+		&api.MultiSearchParams{
+			Conversation:        pointer.True(),
+			ConversationModelId: pointer.String("conv-model-1"),
+			ConversationId:      pointer.String("conv-123"),
+		},
+		api.MultiSearchSearchesParameter{
+			Searches: []api.MultiSearchCollectionParameters{
+				{
+					Collection: "shows",
+					Q:          pointer.String("action series"),
+				},
+			},
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, "conv-123", result.Conversation.ConversationId)
+}
+
+func generateTestScopedSearchKey(t *testing.T, params map[string]interface{}) string {
+	t.Helper()
+	scopedKey, err := (&keys{}).GenerateScopedSearchKey("RN23GFr1s6jQ9kgSNg2O7fYcAUXU7127", params)
+	assert.NoError(t, err)
+	return scopedKey
+}
+
+func TestMultiSearchWithScopedKey(t *testing.T) {
+	scopedKey := generateTestScopedSearchKey(t, map[string]interface{}{
+		"filter_by":  "company_id:124",
+		"per_page":   10,
+		"expires_at": 1906054106,
+	})
+
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, scopedKey, r.Header.Get(api.APIKeyHeader))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": []}`))
+	})
+	defer server.Close()
+
+	_, err := client.MultiSearch.PerformWithScopedKey(context.Background(), scopedKey,
+		&api.MultiSearchParams{PerPage: pointer.Int(10)},
+		api.MultiSearchSearchesParameter{
+			Searches: []api.MultiSearchCollectionParameters{
+				{
+					Collection: "companies",
+					Q:          pointer.String("text"),
+					FilterBy:   pointer.String("num_employees:>100"),
+				},
+			},
+		})
+	assert.NoError(t, err)
+}
+
+func TestMultiSearchWithScopedKeyConflictingParamsReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	scopedKey := generateTestScopedSearchKey(t, map[string]interface{}{
+		"filter_by":      "company_id:124",
+		"per_page":       10,
+		"include_fields": "company_name",
+	})
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.MultiSearch.PerformWithScopedKey(context.Background(), scopedKey,
+		&api.MultiSearchParams{PerPage: pointer.Int(100)},
+		api.MultiSearchSearchesParameter{
+			Searches: []api.MultiSearchCollectionParameters{
+				{
+					Collection: "companies",
+					Q:          pointer.String("text"),
+				},
+				{
+					Collection:    "companies",
+					Q:             pointer.String("text"),
+					IncludeFields: pointer.String("company_name,country"),
+					PerPage:       pointer.Int(10),
+				},
+			},
+		})
+	assert.ErrorIs(t, err, ErrScopedKeyConflict)
+	assert.EqualError(t, err, "search parameters conflict with the scoped search key: "+
+		"per_page (common params), include_fields (search 1)")
+}
+
+func TestMultiSearchWithUnscopedKeySkipsValidation(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "xyz", r.Header.Get(api.APIKeyHeader))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": []}`))
+	})
+	defer server.Close()
+
+	_, err := client.MultiSearch.PerformWithScopedKey(context.Background(), "xyz",
+		&api.MultiSearchParams{PerPage: pointer.Int(100)},
+		api.MultiSearchSearchesParameter{
+			Searches: []api.MultiSearchCollectionParameters{
+				{Collection: "companies", Q: pointer.String("text")},
+			},
+		})
+	assert.NoError(t, err)
+}
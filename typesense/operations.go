@@ -9,6 +9,9 @@ import (
 type OperationsInterface interface {
 	Snapshot(ctx context.Context, snapshotPath string) (bool, error)
 	Vote(ctx context.Context) (bool, error)
+	// CacheClear clears the cached responses of search requests sent with use_cache,
+	// e.g. after bulk updates so that stale results are not served
+	CacheClear(ctx context.Context) (bool, error)
 }
 
 type operations struct {
@@ -37,3 +40,14 @@ func (o *operations) Vote(ctx context.Context) (bool, error) {
 	}
 	return response.JSON200.Success, nil
 }
+
+func (o *operations) CacheClear(ctx context.Context) (bool, error) {
+	response, err := o.apiClient.ClearCacheWithResponse(ctx)
+	if err != nil {
+		return false, err
+	}
+	if response.JSON200 == nil {
+		return false, &HTTPError{Status: response.StatusCode(), Body: response.Body}
+	}
+	return response.JSON200.Success, nil
+}
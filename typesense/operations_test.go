@@ -160,3 +160,47 @@ func TestVoteOnHttpStatusErrorCodeReturnsError(t *testing.T) {
 	assert.Error(t, err)
 	assert.False(t, result)
 }
+
+func TestCacheClear(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r, "/operations/cache/clear", http.MethodPost)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"success": true}`))
+	})
+	defer server.Close()
+
+	result, err := client.Operations().CacheClear(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, result)
+}
+
+func TestCacheClearOnApiClientErrorReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		ClearCacheWithResponse(gomock.Not(gomock.Nil())).
+		Return(nil, errors.New("failed request")).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	result, err := client.Operations().CacheClear(context.Background())
+	assert.Error(t, err)
+	assert.False(t, result)
+}
+
+func TestCacheClearOnHttpStatusErrorCodeReturnsError(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message": "Forbidden - a valid x-typesense-api-key header must be sent."}`))
+	})
+	defer server.Close()
+
+	result, err := client.Operations().CacheClear(context.Background())
+	assert.Equal(t, &HTTPError{
+		Status: http.StatusUnauthorized,
+		Body:   []byte(`{"message": "Forbidden - a valid x-typesense-api-key header must be sent."}`),
+	}, err)
+	assert.False(t, result)
+}
@@ -9,6 +9,8 @@ import (
 // OverrideInterface is a type for Search Override API operations
 type OverrideInterface interface {
 	Retrieve(ctx context.Context) (*api.SearchOverride, error)
+	// Upsert creates or replaces the override and returns it as stored by the server
+	Upsert(ctx context.Context, overrideSchema *api.SearchOverrideSchema) (*api.SearchOverride, error)
 	Delete(ctx context.Context) (*api.SearchOverride, error)
 }
 
@@ -20,6 +22,9 @@ type override struct {
 }
 
 func (o *override) Retrieve(ctx context.Context) (*api.SearchOverride, error) {
+	if err := validateCollectionName(o.collectionName); err != nil {
+		return nil, err
+	}
 	response, err := o.apiClient.GetSearchOverrideWithResponse(ctx,
 		o.collectionName, o.overrideID)
 	if err != nil {
@@ -31,7 +36,25 @@ func (o *override) Retrieve(ctx context.Context) (*api.SearchOverride, error) {
 	return response.JSON200, nil
 }
 
+func (o *override) Upsert(ctx context.Context, overrideSchema *api.SearchOverrideSchema) (*api.SearchOverride, error) {
+	if err := validateCollectionName(o.collectionName); err != nil {
+		return nil, err
+	}
+	response, err := o.apiClient.UpsertSearchOverrideWithResponse(ctx,
+		o.collectionName, o.overrideID, api.UpsertSearchOverrideJSONRequestBody(*overrideSchema))
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, &HTTPError{Status: response.StatusCode(), Body: response.Body}
+	}
+	return response.JSON200, nil
+}
+
 func (o *override) Delete(ctx context.Context) (*api.SearchOverride, error) {
+	if err := validateCollectionName(o.collectionName); err != nil {
+		return nil, err
+	}
 	response, err := o.apiClient.DeleteSearchOverrideWithResponse(ctx,
 		o.collectionName, o.overrideID)
 	if err != nil {
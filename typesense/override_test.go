@@ -2,6 +2,7 @@ package typesense
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"testing"
@@ -73,6 +74,57 @@ func TestSearchOverrideRetrieveOnHttpStatusErrorCodeReturnsError(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestSearchOverrideUpsertByIDRoundTrip(t *testing.T) {
+	schema := createNewSearchOverrideSchema()
+	schema.FilterBy = pointer.String("num_employees:>100")
+	schema.Metadata = &map[string]interface{}{"banner": "Apple deals"}
+
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r, "/collections/companies/overrides/customize-apple", http.MethodPut)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		body["id"] = "customize-apple"
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(jsonEncode(t, body))
+	})
+	defer server.Close()
+
+	result, err := client.Collection("companies").Override("customize-apple").Upsert(context.Background(), schema)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &api.SearchOverride{
+		Id:       pointer.String("customize-apple"),
+		Rule:     schema.Rule,
+		Includes: schema.Includes,
+		Excludes: schema.Excludes,
+		FilterBy: schema.FilterBy,
+		Metadata: schema.Metadata,
+	}, result)
+}
+
+func TestSearchOverrideUpsertByIDOnHttpStatusErrorCodeReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		UpsertSearchOverrideWithResponse(gomock.Not(gomock.Nil()), "companies", "customize-apple",
+			api.UpsertSearchOverrideJSONRequestBody(*createNewSearchOverrideSchema())).
+		Return(&api.UpsertSearchOverrideResponse{
+			HTTPResponse: &http.Response{
+				StatusCode: 400,
+			},
+			Body: []byte("Bad Request"),
+		}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Collection("companies").Override("customize-apple").Upsert(context.Background(), createNewSearchOverrideSchema())
+	assert.Equal(t, &HTTPError{Status: 400, Body: []byte("Bad Request")}, err)
+}
+
 func TestSearchOverrideDelete(t *testing.T) {
 	expectedResult := &api.SearchOverride{Id: pointer.String("customize-apple")}
 
@@ -19,6 +19,9 @@ type overrides struct {
 }
 
 func (o *overrides) Upsert(ctx context.Context, overrideID string, overrideSchema *api.SearchOverrideSchema) (*api.SearchOverride, error) {
+	if err := validateCollectionName(o.collectionName); err != nil {
+		return nil, err
+	}
 	response, err := o.apiClient.UpsertSearchOverrideWithResponse(ctx,
 		o.collectionName, overrideID, api.UpsertSearchOverrideJSONRequestBody(*overrideSchema))
 	if err != nil {
@@ -31,6 +34,9 @@ func (o *overrides) Upsert(ctx context.Context, overrideID string, overrideSchem
 }
 
 func (o *overrides) Retrieve(ctx context.Context) ([]*api.SearchOverride, error) {
+	if err := validateCollectionName(o.collectionName); err != nil {
+		return nil, err
+	}
 	response, err := o.apiClient.GetSearchOverridesWithResponse(ctx, o.collectionName)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,75 @@
+package typesense
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/typesense/typesense-go/v2/typesense/api"
+)
+
+// schemaCache keeps retrieved collection schemas in memory for a limited time.
+// A nil schemaCache caches nothing. The schemas are kept encoded, so that every
+// caller gets its own copy that it may modify.
+type schemaCache struct {
+	ttl     time.Duration
+	now     func() time.Time
+	mu      sync.Mutex
+	entries map[string]schemaCacheEntry
+}
+
+type schemaCacheEntry struct {
+	schema    []byte
+	expiresAt time.Time
+}
+
+func newSchemaCache(ttl time.Duration) *schemaCache {
+	return &schemaCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]schemaCacheEntry),
+	}
+}
+
+func (c *schemaCache) get(collectionName string) (*api.CollectionResponse, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[collectionName]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, collectionName)
+		return nil, false
+	}
+	schema := &api.CollectionResponse{}
+	if err := json.Unmarshal(entry.schema, schema); err != nil {
+		return nil, false
+	}
+	return schema, true
+}
+
+func (c *schemaCache) set(collectionName string, schema *api.CollectionResponse) {
+	if c == nil {
+		return
+	}
+	encoded, err := json.Marshal(schema)
+	if err != nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[collectionName] = schemaCacheEntry{schema: encoded, expiresAt: c.now().Add(c.ttl)}
+}
+
+func (c *schemaCache) invalidate(collectionName string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, collectionName)
+}
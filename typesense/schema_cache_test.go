@@ -0,0 +1,161 @@
+package typesense
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/typesense/typesense-go/v2/typesense/api"
+	"github.com/typesense/typesense-go/v2/typesense/api/pointer"
+	"github.com/typesense/typesense-go/v2/typesense/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func expectGetCollection(mockAPIClient *mocks.MockAPIClientInterface, times int) {
+	mockAPIClient.EXPECT().
+		GetCollectionWithResponse(gomock.Not(gomock.Nil()), "companies").
+		Return(&api.GetCollectionResponse{
+			JSON200: createNewCollection("companies"),
+		}, nil).
+		Times(times)
+}
+
+func TestSchemaCacheHit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+	expectGetCollection(mockAPIClient, 1)
+
+	client := NewClient(WithAPIClient(mockAPIClient), WithSchemaCache(time.Minute))
+	first, err := client.Collection("companies").Retrieve(context.Background())
+	assert.NoError(t, err)
+	second, err := client.Collection("companies").Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	_, err = client.Collection("companies").Documents().Validate(context.Background(),
+		[]map[string]interface{}{{"company_name": "Stark Industries", "num_employees": 5215, "country": "USA"}})
+	assert.NoError(t, err)
+}
+
+func TestSchemaCacheMissAfterTTL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+	expectGetCollection(mockAPIClient, 2)
+
+	client := NewClient(WithAPIClient(mockAPIClient), WithSchemaCache(time.Minute))
+	now := time.Now()
+	client.schemaCache.now = func() time.Time { return now }
+
+	_, err := client.Collection("companies").Retrieve(context.Background())
+	assert.NoError(t, err)
+
+	now = now.Add(59 * time.Second)
+	_, err = client.Collection("companies").Retrieve(context.Background())
+	assert.NoError(t, err)
+
+	now = now.Add(time.Second)
+	_, err = client.Collection("companies").Retrieve(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestSchemaCacheInvalidatedOnUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+	expectGetCollection(mockAPIClient, 2)
+
+	updateSchema := &api.CollectionUpdateSchema{Fields: []api.Field{{Name: "country", Drop: pointer.True()}}}
+	mockAPIClient.EXPECT().
+		UpdateCollectionWithResponse(gomock.Not(gomock.Nil()), "companies",
+			api.UpdateCollectionJSONRequestBody(*updateSchema)).
+		Return(&api.UpdateCollectionResponse{JSON200: updateSchema}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient), WithSchemaCache(time.Minute))
+	_, err := client.Collection("companies").Retrieve(context.Background())
+	assert.NoError(t, err)
+	_, err = client.Collection("companies").Update(context.Background(), updateSchema)
+	assert.NoError(t, err)
+	_, err = client.Collection("companies").Retrieve(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestSchemaCacheInvalidatedOnDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+	expectGetCollection(mockAPIClient, 2)
+
+	mockAPIClient.EXPECT().
+		DeleteCollectionWithResponse(gomock.Not(gomock.Nil()), "companies").
+		Return(&api.DeleteCollectionResponse{JSON200: createNewCollection("companies")}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient), WithSchemaCache(time.Minute))
+	_, err := client.Collection("companies").Retrieve(context.Background())
+	assert.NoError(t, err)
+	_, err = client.Collection("companies").Delete(context.Background())
+	assert.NoError(t, err)
+	_, err = client.Collection("companies").Retrieve(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestSchemaCacheInvalidatedOnCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+	expectGetCollection(mockAPIClient, 2)
+
+	schema := createNewSchema("companies")
+	mockAPIClient.EXPECT().
+		CreateCollectionWithResponse(gomock.Not(gomock.Nil()), api.CreateCollectionJSONRequestBody(*schema)).
+		Return(&api.CreateCollectionResponse{JSON201: createNewCollection("companies")}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient), WithSchemaCache(time.Minute))
+	_, err := client.Collection("companies").Retrieve(context.Background())
+	assert.NoError(t, err)
+	_, err = client.Collections().Create(context.Background(), schema)
+	assert.NoError(t, err)
+	_, err = client.Collection("companies").Retrieve(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestSchemaCacheReturnsCopies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+	expectGetCollection(mockAPIClient, 1)
+
+	client := NewClient(WithAPIClient(mockAPIClient), WithSchemaCache(time.Minute))
+	first, err := client.Collection("companies").Retrieve(context.Background())
+	assert.NoError(t, err)
+	first.Name = "changed"
+	*first.NumDocuments = 100
+
+	second, err := client.Collection("companies").Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, createNewCollection("companies"), second)
+	*second.NumDocuments = 200
+
+	third, err := client.Collection("companies").Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, createNewCollection("companies"), third)
+}
+
+func TestSchemaCacheDisabledByDefault(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+	expectGetCollection(mockAPIClient, 2)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	assert.Nil(t, client.schemaCache)
+	_, err := client.Collection("companies").Retrieve(context.Background())
+	assert.NoError(t, err)
+	_, err = client.Collection("companies").Retrieve(context.Background())
+	assert.NoError(t, err)
+}
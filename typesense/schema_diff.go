@@ -0,0 +1,235 @@
+package typesense
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/typesense/typesense-go/v2/typesense/api"
+	"github.com/typesense/typesense-go/v2/typesense/api/pointer"
+)
+
+// SchemaDiff describes the differences between the schema of a live collection
+// and a desired collection schema.
+type SchemaDiff struct {
+	// AddedFields are present in the desired schema only, their Current is empty
+	AddedFields []FieldDiff
+	// DroppedFields are present in the live schema only, their Desired is empty
+	DroppedFields []FieldDiff
+	// ChangedFields are present in both schemas with different attributes
+	ChangedFields []FieldDiff
+	// ChangedSettings are the collection level settings that differ
+	ChangedSettings []SettingDiff
+}
+
+// ChangeKind classifies a change by how it can be applied to the live collection.
+// An empty ChangeKind is unknown and handled like ChangeRequiresReindex.
+type ChangeKind string
+
+const (
+	// ChangeAlterable changes are applied in place with the alter request of UpdateSchema
+	ChangeAlterable ChangeKind = "alterable"
+	// ChangeRequiresReindex changes require creating a new collection with the desired
+	// schema and reindexing the documents into it, e.g. before swapping an alias
+	ChangeRequiresReindex ChangeKind = "requires_reindex"
+)
+
+// FieldDiff holds the live and desired definitions of an added, dropped or changed field.
+type FieldDiff struct {
+	Name    string
+	Current api.Field
+	Desired api.Field
+	Kind    ChangeKind
+}
+
+// SettingDiff holds the live and desired values of a changed collection setting,
+// e.g. "default_sorting_field".
+type SettingDiff struct {
+	Name    string
+	Current interface{}
+	Desired interface{}
+	Kind    ChangeKind
+}
+
+// ErrSettingNotAlterable is returned by CheckAlterable when a collection level
+// setting that the server can not alter has changed
+var ErrSettingNotAlterable = errors.New("collection setting can not be altered")
+
+// alterableSettings are the collection level settings accepted by the alter request.
+// Other settings, like symbols_to_index and token_separators, can only be set when
+// the collection is created.
+var alterableSettings = map[string]bool{
+	"metadata": true,
+}
+
+// reindexFieldAttributes are the field attributes that change how the stored values
+// are indexed, so that changing them requires reindexing the documents.
+var reindexFieldAttributes = map[string]bool{
+	"type":    true,
+	"num_dim": true,
+}
+
+// HasChanges reports whether the schemas differ.
+func (d *SchemaDiff) HasChanges() bool {
+	return len(d.AddedFields) != 0 || len(d.DroppedFields) != 0 ||
+		len(d.ChangedFields) != 0 || len(d.ChangedSettings) != 0
+}
+
+// RequiresReindex reports whether any field or setting change of the diff is not
+// classified as ChangeAlterable.
+func (d *SchemaDiff) RequiresReindex() bool {
+	for _, fields := range [][]FieldDiff{d.AddedFields, d.DroppedFields, d.ChangedFields} {
+		for _, field := range fields {
+			if field.Kind != ChangeAlterable {
+				return true
+			}
+		}
+	}
+	for _, setting := range d.ChangedSettings {
+		if setting.Kind != ChangeAlterable {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckAlterable returns an error wrapping ErrSettingNotAlterable when the diff
+// changes collection level settings that are not classified as ChangeAlterable.
+// Applying such a diff requires recreating the collection.
+func (d *SchemaDiff) CheckAlterable() error {
+	var names []string
+	for _, setting := range d.ChangedSettings {
+		if setting.Kind != ChangeAlterable {
+			names = append(names, setting.Name)
+		}
+	}
+	if len(names) > 0 {
+		return fmt.Errorf("%w: %s", ErrSettingNotAlterable, strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// UpdateSchema returns the alter request that applies the changes of the diff:
+// dropped fields are dropped, added fields are added and changed fields are dropped
+// and re-added with the desired definition. Changed metadata is replaced. The other
+// collection level settings can not be altered and are not part of the request,
+// see CheckAlterable. Use RequiresReindex to check that the alter request can be
+// used at all.
+func (d *SchemaDiff) UpdateSchema() *api.CollectionUpdateSchema {
+	fields := make([]api.Field, 0, len(d.AddedFields)+len(d.DroppedFields)+2*len(d.ChangedFields))
+	for _, field := range d.DroppedFields {
+		fields = append(fields, api.Field{Name: field.Name, Drop: pointer.True()})
+	}
+	for _, field := range d.ChangedFields {
+		fields = append(fields, api.Field{Name: field.Name, Drop: pointer.True()}, field.Desired)
+	}
+	for _, field := range d.AddedFields {
+		fields = append(fields, field.Desired)
+	}
+	updateSchema := &api.CollectionUpdateSchema{Fields: fields}
+	for _, setting := range d.ChangedSettings {
+		if setting.Name == "metadata" {
+			updateSchema.Metadata = setting.Desired.(*map[string]interface{})
+		}
+	}
+	return updateSchema
+}
+
+// DiffSchema computes the differences between the live collection schema and the
+// desired one. Only the attributes and settings that are set in the desired schema
+// are compared, so that server side defaults are not reported as changes.
+func DiffSchema(live *api.CollectionResponse, desired *api.CollectionSchema) (*SchemaDiff, error) {
+	diff := &SchemaDiff{}
+
+	liveFields := make(map[string]api.Field, len(live.Fields))
+	for _, field := range live.Fields {
+		liveFields[field.Name] = field
+	}
+	desiredFields := make(map[string]struct{}, len(desired.Fields))
+	for _, field := range desired.Fields {
+		desiredFields[field.Name] = struct{}{}
+		current, ok := liveFields[field.Name]
+		if !ok {
+			diff.AddedFields = append(diff.AddedFields, FieldDiff{Name: field.Name, Desired: field, Kind: ChangeAlterable})
+			continue
+		}
+		changed, err := changedAttributes(field, current)
+		if err != nil {
+			return nil, err
+		}
+		if len(changed) == 0 {
+			continue
+		}
+		kind := ChangeAlterable
+		for _, name := range changed {
+			if reindexFieldAttributes[name] {
+				kind = ChangeRequiresReindex
+			}
+		}
+		diff.ChangedFields = append(diff.ChangedFields, FieldDiff{Name: field.Name, Current: current, Desired: field, Kind: kind})
+	}
+	for _, field := range live.Fields {
+		if _, ok := desiredFields[field.Name]; !ok {
+			diff.DroppedFields = append(diff.DroppedFields, FieldDiff{Name: field.Name, Current: field, Kind: ChangeAlterable})
+		}
+	}
+
+	settings := []struct {
+		name    string
+		current interface{}
+		desired interface{}
+	}{
+		{"default_sorting_field", live.DefaultSortingField, desired.DefaultSortingField},
+		{"enable_nested_fields", live.EnableNestedFields, desired.EnableNestedFields},
+		{"symbols_to_index", live.SymbolsToIndex, desired.SymbolsToIndex},
+		{"token_separators", live.TokenSeparators, desired.TokenSeparators},
+		{"metadata", live.Metadata, desired.Metadata},
+	}
+	for _, setting := range settings {
+		if reflect.ValueOf(setting.desired).IsNil() {
+			continue
+		}
+		if !reflect.DeepEqual(setting.current, setting.desired) {
+			kind := ChangeRequiresReindex
+			if alterableSettings[setting.name] {
+				kind = ChangeAlterable
+			}
+			diff.ChangedSettings = append(diff.ChangedSettings,
+				SettingDiff{Name: setting.name, Current: setting.current, Desired: setting.desired, Kind: kind})
+		}
+	}
+	return diff, nil
+}
+
+// changedAttributes returns the attributes set in desired that have a different value in current
+func changedAttributes(desired api.Field, current api.Field) ([]string, error) {
+	desiredAttrs, err := fieldAttributes(desired)
+	if err != nil {
+		return nil, err
+	}
+	currentAttrs, err := fieldAttributes(current)
+	if err != nil {
+		return nil, err
+	}
+	var changed []string
+	for name, value := range desiredAttrs {
+		if !reflect.DeepEqual(value, currentAttrs[name]) {
+			changed = append(changed, name)
+		}
+	}
+	return changed, nil
+}
+
+func fieldAttributes(field api.Field) (map[string]interface{}, error) {
+	data, err := json.Marshal(field)
+	if err != nil {
+		return nil, err
+	}
+	var attrs map[string]interface{}
+	if err := json.Unmarshal(data, &attrs); err != nil {
+		return nil, err
+	}
+	return attrs, nil
+}
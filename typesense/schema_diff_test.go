@@ -0,0 +1,225 @@
+package typesense
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/typesense/typesense-go/v2/typesense/api"
+	"github.com/typesense/typesense-go/v2/typesense/api/pointer"
+	"github.com/typesense/typesense-go/v2/typesense/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func newLiveCollection() *api.CollectionResponse {
+	return &api.CollectionResponse{
+		Name: "companies",
+		Fields: []api.Field{
+			{Name: "company_name", Type: "string", Facet: pointer.False(), Index: pointer.True(), Optional: pointer.False()},
+			{Name: "num_employees", Type: "int32", Facet: pointer.False(), Index: pointer.True(), Optional: pointer.False()},
+			{Name: "country", Type: "string", Facet: pointer.True(), Index: pointer.True(), Optional: pointer.False()},
+		},
+		DefaultSortingField: pointer.String("num_employees"),
+		EnableNestedFields:  pointer.False(),
+		NumDocuments:        pointer.Int64(0),
+	}
+}
+
+func TestDiffSchemaWithoutChanges(t *testing.T) {
+	desired := createNewSchema("companies")
+	desired.Fields = desired.Fields[:3]
+
+	diff, err := DiffSchema(newLiveCollection(), desired)
+	assert.NoError(t, err)
+	assert.False(t, diff.HasChanges())
+	assert.Equal(t, &SchemaDiff{}, diff)
+}
+
+func TestDiffSchemaWithAddedAndDroppedFields(t *testing.T) {
+	desired := &api.CollectionSchema{
+		Name: "companies",
+		Fields: []api.Field{
+			{Name: "company_name", Type: "string"},
+			{Name: "num_employees", Type: "int32"},
+			{Name: "founded", Type: "int64", Optional: pointer.True()},
+		},
+	}
+
+	diff, err := DiffSchema(newLiveCollection(), desired)
+	assert.NoError(t, err)
+	assert.True(t, diff.HasChanges())
+	assert.Equal(t, []FieldDiff{
+		{Name: "founded", Desired: api.Field{Name: "founded", Type: "int64", Optional: pointer.True()}, Kind: ChangeAlterable},
+	}, diff.AddedFields)
+	assert.Equal(t, []FieldDiff{
+		{Name: "country", Current: newLiveCollection().Fields[2], Kind: ChangeAlterable},
+	}, diff.DroppedFields)
+	assert.Empty(t, diff.ChangedFields)
+	assert.Empty(t, diff.ChangedSettings)
+
+	assert.Equal(t, &api.CollectionUpdateSchema{
+		Fields: []api.Field{
+			{Name: "country", Drop: pointer.True()},
+			{Name: "founded", Type: "int64", Optional: pointer.True()},
+		},
+	}, diff.UpdateSchema())
+}
+
+func TestDiffSchemaWithChangedFieldsAndSettings(t *testing.T) {
+	desired := &api.CollectionSchema{
+		Name: "companies",
+		Fields: []api.Field{
+			{Name: "company_name", Type: "string", Facet: pointer.True()},
+			{Name: "num_employees", Type: "int64"},
+			{Name: "country", Type: "string", Facet: pointer.True()},
+		},
+		DefaultSortingField: pointer.String("num_employees"),
+		TokenSeparators:     &[]string{"-"},
+	}
+
+	diff, err := DiffSchema(newLiveCollection(), desired)
+	assert.NoError(t, err)
+	assert.Empty(t, diff.AddedFields)
+	assert.Empty(t, diff.DroppedFields)
+	assert.Equal(t, []FieldDiff{
+		{Name: "company_name", Current: newLiveCollection().Fields[0], Desired: desired.Fields[0], Kind: ChangeAlterable},
+		{Name: "num_employees", Current: newLiveCollection().Fields[1], Desired: desired.Fields[1], Kind: ChangeRequiresReindex},
+	}, diff.ChangedFields)
+	assert.Equal(t, []SettingDiff{
+		{Name: "token_separators", Current: (*[]string)(nil), Desired: &[]string{"-"}, Kind: ChangeRequiresReindex},
+	}, diff.ChangedSettings)
+	assert.True(t, diff.RequiresReindex())
+
+	assert.Equal(t, &api.CollectionUpdateSchema{
+		Fields: []api.Field{
+			{Name: "company_name", Drop: pointer.True()},
+			desired.Fields[0],
+			{Name: "num_employees", Drop: pointer.True()},
+			desired.Fields[1],
+		},
+	}, diff.UpdateSchema())
+	assert.ErrorIs(t, diff.CheckAlterable(), ErrSettingNotAlterable)
+	assert.EqualError(t, diff.CheckAlterable(), "collection setting can not be altered: token_separators")
+}
+
+func TestDiffSchemaWithChangedMetadata(t *testing.T) {
+	live := newLiveCollection()
+	live.Metadata = &map[string]interface{}{"owner": "search-team"}
+	desired := createNewSchema("companies")
+	desired.Fields = desired.Fields[:3]
+	desired.Metadata = &map[string]interface{}{"owner": "platform-team"}
+
+	diff, err := DiffSchema(live, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, []SettingDiff{
+		{Name: "metadata", Current: live.Metadata, Desired: desired.Metadata, Kind: ChangeAlterable},
+	}, diff.ChangedSettings)
+	assert.NoError(t, diff.CheckAlterable())
+	assert.False(t, diff.RequiresReindex())
+	assert.Equal(t, &api.CollectionUpdateSchema{
+		Fields:   []api.Field{},
+		Metadata: desired.Metadata,
+	}, diff.UpdateSchema())
+}
+
+func TestDiffSchemaClassifiesFieldChanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    api.Field
+		expected ChangeKind
+	}{
+		{"facet toggle", api.Field{Name: "country", Type: "string", Facet: pointer.False()}, ChangeAlterable},
+		{"index toggle", api.Field{Name: "country", Type: "string", Index: pointer.False()}, ChangeAlterable},
+		{"type change", api.Field{Name: "country", Type: "string[]"}, ChangeRequiresReindex},
+		{"type change and facet toggle", api.Field{Name: "country", Type: "int32", Facet: pointer.False()}, ChangeRequiresReindex},
+		{"num_dim change", api.Field{Name: "country", Type: "string", NumDim: pointer.Int(384)}, ChangeRequiresReindex},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := &api.CollectionSchema{
+				Name: "companies",
+				Fields: []api.Field{
+					{Name: "company_name", Type: "string"},
+					{Name: "num_employees", Type: "int32"},
+					tt.field,
+				},
+			}
+
+			diff, err := DiffSchema(newLiveCollection(), desired)
+			assert.NoError(t, err)
+			if assert.Len(t, diff.ChangedFields, 1) {
+				assert.Equal(t, "country", diff.ChangedFields[0].Name)
+				assert.Equal(t, tt.expected, diff.ChangedFields[0].Kind)
+			}
+			assert.Equal(t, tt.expected == ChangeRequiresReindex, diff.RequiresReindex())
+			assert.NoError(t, diff.CheckAlterable())
+		})
+	}
+}
+
+func TestDiffSchemaWithAddedAndDroppedFieldsIsAlterable(t *testing.T) {
+	desired := &api.CollectionSchema{
+		Name:   "companies",
+		Fields: []api.Field{{Name: "founded", Type: "int64", Optional: pointer.True()}},
+	}
+
+	diff, err := DiffSchema(newLiveCollection(), desired)
+	assert.NoError(t, err)
+	assert.True(t, diff.HasChanges())
+	assert.False(t, diff.RequiresReindex())
+	assert.NoError(t, diff.CheckAlterable())
+}
+
+func TestSchemaDiffWithUnknownKindIsNotAlterable(t *testing.T) {
+	diff := &SchemaDiff{
+		ChangedSettings: []SettingDiff{{Name: "metadata", Current: nil, Desired: &map[string]interface{}{}}},
+	}
+	assert.True(t, diff.RequiresReindex())
+	assert.ErrorIs(t, diff.CheckAlterable(), ErrSettingNotAlterable)
+
+	diff = &SchemaDiff{AddedFields: []FieldDiff{{Name: "founded", Desired: api.Field{Name: "founded", Type: "int64"}}}}
+	assert.True(t, diff.RequiresReindex())
+}
+
+func TestCollectionSchemaDiff(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		GetCollectionWithResponse(gomock.Not(gomock.Nil()), "companies").
+		Return(&api.GetCollectionResponse{
+			JSON200: newLiveCollection(),
+		}, nil).
+		Times(1)
+
+	desired := &api.CollectionSchema{
+		Name:   "companies",
+		Fields: []api.Field{{Name: "company_name", Type: "string"}},
+	}
+	client := NewClient(WithAPIClient(mockAPIClient))
+	diff, err := client.Collection("companies").SchemaDiff(context.Background(), desired)
+
+	assert.NoError(t, err)
+	assert.Empty(t, diff.AddedFields)
+	assert.Equal(t, []FieldDiff{
+		{Name: "num_employees", Current: newLiveCollection().Fields[1], Kind: ChangeAlterable},
+		{Name: "country", Current: newLiveCollection().Fields[2], Kind: ChangeAlterable},
+	}, diff.DroppedFields)
+}
+
+func TestCollectionSchemaDiffOnApiClientErrorReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		GetCollectionWithResponse(gomock.Not(gomock.Nil()), "companies").
+		Return(nil, errors.New("failed request")).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Collection("companies").SchemaDiff(context.Background(), createNewSchema("companies"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,103 @@
+package typesense
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ScrollIterator iterates over all documents of a collection, reading them from
+// an export of the collection page by page. The export streams the documents in
+// a stable order and returns each document at most once: documents removed during
+// the iteration are skipped once they are reached and documents added during the
+// iteration may or may not be returned. The iterator is closed once all documents
+// are read; it must be closed explicitly if the iteration is stopped early:
+//
+//	for it.Next() {
+//		fmt.Println(it.Doc()["id"])
+//	}
+//	if err := it.Err(); err != nil { ... }
+type ScrollIterator struct {
+	body     io.ReadCloser
+	reader   *bufio.Reader
+	pageSize int
+	page     []map[string]interface{}
+	pos      int
+	err      error
+	done     bool
+}
+
+func newScrollIterator(body io.ReadCloser, pageSize int) *ScrollIterator {
+	return &ScrollIterator{body: body, reader: bufio.NewReader(body), pageSize: pageSize}
+}
+
+// Next advances to the next document and reports whether there is one.
+func (it *ScrollIterator) Next() bool {
+	if it.pos+1 < len(it.page) {
+		it.pos++
+		return true
+	}
+	if it.done {
+		return false
+	}
+	if err := it.readPage(); err != nil {
+		it.err = err
+		it.page = nil
+		it.Close()
+		return false
+	}
+	it.pos = 0
+	if len(it.page) == 0 {
+		it.Close()
+		return false
+	}
+	return true
+}
+
+// readPage reads the next page of up to pageSize documents
+func (it *ScrollIterator) readPage() error {
+	it.page = it.page[:0]
+	for len(it.page) < it.pageSize {
+		line, err := it.reader.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) != 0 {
+			var doc map[string]interface{}
+			if err := json.Unmarshal(line, &doc); err != nil {
+				return fmt.Errorf("failed to decode exported document: %w", err)
+			}
+			it.page = append(it.page, doc)
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Doc returns the current document
+func (it *ScrollIterator) Doc() map[string]interface{} {
+	if it.pos >= len(it.page) {
+		return nil
+	}
+	return it.page[it.pos]
+}
+
+// Err returns the error that ended the iteration, if any
+func (it *ScrollIterator) Err() error {
+	return it.err
+}
+
+// Close closes the underlying export. It is safe to call Close several times.
+func (it *ScrollIterator) Close() error {
+	if it.done {
+		return nil
+	}
+	it.done = true
+	it.page = nil
+	return it.body.Close()
+}
@@ -0,0 +1,107 @@
+package typesense
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/typesense/typesense-go/v2/typesense/api"
+	"github.com/typesense/typesense-go/v2/typesense/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func scrollIDs(it *ScrollIterator) []interface{} {
+	var ids []interface{}
+	for it.Next() {
+		ids = append(ids, it.Doc()["id"])
+	}
+	return ids
+}
+
+func TestDocumentsScroll(t *testing.T) {
+	exported := `{"id": "124","company_name":"Stark Industries","num_employees":5215}` + "\n" +
+		`{"id": "125","company_name":"Future Technology","num_employees":1232}` + "\n" +
+		"\n" +
+		`{"id": "126","company_name":"Wayne Enterprises","num_employees":8000}` + "\n"
+	body := &closeRecordingReader{Reader: strings.NewReader(exported)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		ExportDocuments(gomock.Not(gomock.Nil()), "companies", &api.ExportDocumentsParams{}).
+		Return(&http.Response{StatusCode: http.StatusOK, Body: body}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	it, err := client.Collection("companies").Documents().Scroll(context.Background(), 2)
+	assert.NoError(t, err)
+
+	assert.True(t, it.Next())
+	assert.Equal(t, map[string]interface{}{
+		"id":            "124",
+		"company_name":  "Stark Industries",
+		"num_employees": float64(5215),
+	}, it.Doc())
+	assert.Equal(t, []interface{}{"125", "126"}, scrollIDs(it))
+	assert.NoError(t, it.Err())
+	assert.Nil(t, it.Doc())
+	assert.False(t, it.Next())
+	assert.NoError(t, it.Close())
+	assert.Equal(t, 1, body.closed)
+}
+
+func TestDocumentsScrollWithEmptyCollection(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r, "/collections/companies/documents/export", http.MethodGet)
+		w.Header().Set("Content-Type", "application/octet-stream")
+	})
+	defer server.Close()
+
+	it, err := client.Collection("companies").Documents().Scroll(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Empty(t, scrollIDs(it))
+	assert.NoError(t, it.Err())
+}
+
+func TestDocumentsScrollOnHttpStatusErrorCodeReturnsError(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "Not Found"}`))
+	})
+	defer server.Close()
+
+	_, err := client.Collection("companies").Documents().Scroll(context.Background(), 10)
+	assert.Equal(t, &HTTPError{Status: http.StatusNotFound, Body: []byte(`{"message": "Not Found"}`)}, err)
+}
+
+func TestDocumentsScrollWithInvalidPageSizeReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Collection("companies").Documents().Scroll(context.Background(), 0)
+	assert.EqualError(t, err, "invalid page size 0")
+}
+
+func TestScrollIteratorWithMalformedDocumentReturnsError(t *testing.T) {
+	body := &closeRecordingReader{Reader: strings.NewReader(`{"id": "124"}` + "\n" + `{"id": "125"}` + "\n" + `{"id":` + "\n")}
+	it := newScrollIterator(body, 2)
+	assert.Equal(t, []interface{}{"124", "125"}, scrollIDs(it))
+	assert.ErrorContains(t, it.Err(), "failed to decode exported document")
+	assert.Equal(t, 1, body.closed)
+}
+
+func TestScrollIteratorCloseStopsIteration(t *testing.T) {
+	body := &closeRecordingReader{Reader: strings.NewReader(`{"id": "124"}` + "\n" + `{"id": "125"}` + "\n")}
+	it := newScrollIterator(body, 10)
+	assert.True(t, it.Next())
+	assert.NoError(t, it.Close())
+	assert.False(t, it.Next())
+	assert.NoError(t, it.Close())
+	assert.Equal(t, 1, body.closed)
+}
@@ -2,6 +2,7 @@ package typesense
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -174,3 +175,965 @@ func TestCollectionSearchOnHttpStatusErrorCodeReturnsError(t *testing.T) {
 	_, err := client.Collection("companies").Documents().Search(context.Background(), params)
 	assert.NotNil(t, err)
 }
+
+func TestSearchResultVoiceQueryDeserialization(t *testing.T) {
+	inputJSON := `{
+		"found": 0,
+		"hits": [],
+		"request_params": {
+		  "collection_name": "products",
+		  "per_page": 10,
+		  "q": "smart phone",
+		  "voice_query": {
+			"transcribed_query": "smart phone"
+		  }
+		}
+	  }`
+	result := &api.SearchResult{}
+	err := json.Unmarshal([]byte(inputJSON), result)
+	assert.NoError(t, err)
+	assert.Equal(t, "smart phone", result.RequestParams.Q)
+	assert.Equal(t, pointer.String("smart phone"), result.RequestParams.VoiceQuery.TranscribedQuery)
+
+	result = &api.SearchResult{}
+	err = json.Unmarshal([]byte(`{"request_params": {"collection_name": "products", "per_page": 10, "q": "phone"}}`), result)
+	assert.NoError(t, err)
+	assert.Nil(t, result.RequestParams.VoiceQuery)
+}
+
+func TestSearchResultParsedNlQueryDeserialization(t *testing.T) {
+	inputJSON := `{
+		"found": 2,
+		"hits": [],
+		"parsed_nl_query": {
+		  "parse_time_ms": 412,
+		  "generated_params": {
+			"q": "shoes",
+			"filter_by": "price:<100",
+			"sort_by": "rating:desc"
+		  },
+		  "augmented_params": {
+			"q": "shoes",
+			"filter_by": "in_stock:true && price:<100",
+			"sort_by": "rating:desc"
+		  }
+		}
+	  }`
+	result := &api.SearchResult{}
+	err := json.Unmarshal([]byte(inputJSON), result)
+	assert.NoError(t, err)
+	assert.Equal(t, pointer.Int(412), result.ParsedNlQuery.ParseTimeMs)
+	assert.Equal(t, &map[string]interface{}{
+		"q":         "shoes",
+		"filter_by": "price:<100",
+		"sort_by":   "rating:desc",
+	}, result.ParsedNlQuery.GeneratedParams)
+	assert.Equal(t, "in_stock:true && price:<100", (*result.ParsedNlQuery.AugmentedParams)["filter_by"])
+}
+
+func TestFacetCountsStatsDeserialization(t *testing.T) {
+	inputJSON := `{
+		"field_name": "brand",
+		"counts": [
+		  {"count": 12, "highlighted": "Acme", "value": "Acme"},
+		  {"count": 7, "highlighted": "Globex", "value": "Globex"}
+		],
+		"stats": {"total_values": 38}
+	  }`
+	facetCounts := &api.FacetCounts{}
+	err := json.Unmarshal([]byte(inputJSON), facetCounts)
+	assert.NoError(t, err)
+	assert.Len(t, *facetCounts.Counts, 2)
+	// more distinct values exist than the returned counts
+	assert.Equal(t, pointer.Int(38), facetCounts.Stats.TotalValues)
+
+	inputJSON = `{
+		"field_name": "price",
+		"counts": [{"count": 3, "value": "9.99"}],
+		"stats": {"avg": 19.5, "max": 29.99, "min": 9.99, "sum": 58.5, "total_values": 3}
+	  }`
+	facetCounts = &api.FacetCounts{}
+	err = json.Unmarshal([]byte(inputJSON), facetCounts)
+	assert.NoError(t, err)
+	assert.Equal(t, pointer.Int(3), facetCounts.Stats.TotalValues)
+	assert.Equal(t, pointer.Float64(29.99), facetCounts.Stats.Max)
+}
+
+func TestSearchResultNestedHighlightDeserialization(t *testing.T) {
+	inputJSON := `{
+		"found": 1,
+		"hits": [
+		  {
+			"highlights": [
+			  {
+				"field": "author.name",
+				"snippet": "<mark>Tony</mark> Stark",
+				"matched_tokens": [["Tony"]]
+			  }
+			],
+			"highlight": {
+			  "author": {
+				"name": {
+				  "snippet": "<mark>Tony</mark> Stark",
+				  "matched_tokens": ["Tony"]
+				},
+				"tags": {
+				  "snippets": ["<mark>Tony</mark>"],
+				  "matched_tokens": [["Tony"], []]
+				}
+			  }
+			},
+			"document": {
+			  "id": "124",
+			  "author": {"name": "Tony Stark", "tags": ["Tony", "Iron Man"]}
+			}
+		  }
+		]
+	  }`
+
+	result := &api.SearchResult{}
+	err := json.Unmarshal([]byte(inputJSON), &result)
+	assert.NoError(t, err)
+
+	hit := (*result.Hits)[0]
+	highlights := *hit.Highlights
+	assert.Equal(t, "author.name", *highlights[0].Field)
+	assert.Equal(t, []interface{}{[]interface{}{"Tony"}}, *highlights[0].MatchedTokens)
+
+	name, ok := hit.HighlightField("author.name")
+	assert.True(t, ok)
+	assert.Equal(t, &api.SearchHighlight{
+		Field:         pointer.String("author.name"),
+		Snippet:       pointer.String("<mark>Tony</mark> Stark"),
+		MatchedTokens: &[]interface{}{"Tony"},
+	}, name)
+
+	tags, ok := hit.HighlightField("author.tags")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"<mark>Tony</mark>"}, *tags.Snippets)
+	assert.Equal(t, []interface{}{[]interface{}{"Tony"}, []interface{}{}}, *tags.MatchedTokens)
+
+	_, ok = hit.HighlightField("author")
+	assert.False(t, ok)
+	_, ok = hit.HighlightField("author.missing")
+	assert.False(t, ok)
+	_, ok = hit.HighlightField("author.name.snippet")
+	assert.False(t, ok)
+}
+
+func TestCollectionSearchWithSearchCutoff(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/collections/companies/documents/search", r.URL.Path)
+		assert.Equal(t, "50", r.URL.Query().Get("search_cutoff_ms"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"found": 1, "hits": [], "search_cutoff": true}`))
+	})
+	defer server.Close()
+
+	result, err := client.Collection("companies").Documents().Search(context.Background(), &api.SearchCollectionParams{
+		Q:              pointer.String("text"),
+		QueryBy:        pointer.String("company_name"),
+		SearchCutoffMs: pointer.Int(50),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, pointer.True(), result.SearchCutoff)
+}
+
+func TestCollectionSearchValidatesPagination(t *testing.T) {
+	tests := []struct {
+		name        string
+		page        *int
+		perPage     *int
+		expectedErr string
+	}{
+		{name: "no pagination"},
+		{name: "per_page zero", perPage: pointer.Int(0)},
+		{name: "per_page at maximum", perPage: pointer.Int(MaxPerPage)},
+		{name: "per_page above maximum", perPage: pointer.Int(MaxPerPage + 1), expectedErr: "per_page: 251"},
+		{name: "negative per_page", perPage: pointer.Int(-1), expectedErr: "per_page: -1"},
+		{name: "first page", page: pointer.Int(1), perPage: pointer.Int(MaxPerPage)},
+		{name: "negative page", page: pointer.Int(-1), expectedErr: "page: -1"},
+		{name: "deepest page", page: pointer.Int(MaxSearchHits / MaxPerPage), perPage: pointer.Int(MaxPerPage)},
+		{name: "page beyond limit", page: pointer.Int(MaxSearchHits/MaxPerPage + 1), perPage: pointer.Int(MaxPerPage), expectedErr: "page: 17179870"},
+		{name: "page beyond limit with default per_page", page: pointer.Int(MaxSearchHits/10 + 1), expectedErr: "page: 429496730"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+			params := &api.SearchCollectionParams{Q: pointer.String("*"), Page: tt.page, PerPage: tt.perPage}
+			if tt.expectedErr == "" {
+				mockAPIClient.EXPECT().
+					SearchCollectionWithResponse(gomock.Not(gomock.Nil()), "companies", params).
+					Return(&api.SearchCollectionResponse{JSON200: &api.SearchResult{}}, nil).
+					Times(1)
+			}
+
+			client := NewClient(WithAPIClient(mockAPIClient))
+			_, err := client.Collection("companies").Documents().Search(context.Background(), params)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, "invalid search parameter "+tt.expectedErr)
+			}
+		})
+	}
+}
+
+// assertSearchQueryEncoding performs a search with the given params against a
+// test server and asserts that the expected query parameters are sent.
+func assertSearchQueryEncoding(t *testing.T, params *api.SearchCollectionParams, expected map[string]string) {
+	t.Helper()
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/collections/companies/documents/search", r.URL.Path)
+		for name, value := range expected {
+			assert.Equal(t, value, r.URL.Query().Get(name), name)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"found": 0, "hits": []}`))
+	})
+	defer server.Close()
+
+	_, err := client.Collection("companies").Documents().Search(context.Background(), params)
+	assert.NoError(t, err)
+}
+
+func TestCollectionSearchWithSynonymParams(t *testing.T) {
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:               pointer.String("text"),
+		QueryBy:         pointer.String("company_name"),
+		EnableSynonyms:  pointer.False(),
+		SynonymPrefix:   pointer.True(),
+		SynonymNumTypos: pointer.Int(1),
+	}, map[string]string{
+		"enable_synonyms":   "false",
+		"synonym_prefix":    "true",
+		"synonym_num_typos": "1",
+	})
+}
+
+func TestSearchResultTextMatchInfoDeserialization(t *testing.T) {
+	inputJSON := `{
+		"found": 1,
+		"hits": [
+		  {
+			"document": {"id": "124", "company_name": "Stark Industries"},
+			"highlight": {},
+			"highlights": [],
+			"text_match": 578730123365187705,
+			"text_match_info": {
+			  "best_field_score": "1108091339008",
+			  "best_field_weight": 15,
+			  "fields_matched": 1,
+			  "num_tokens_dropped": 0,
+			  "score": "578730123365187705",
+			  "tokens_matched": 1,
+			  "typo_prefix_score": 0
+			}
+		  }
+		]
+	  }`
+
+	result := &api.SearchResult{}
+	err := json.Unmarshal([]byte(inputJSON), &result)
+	assert.NoError(t, err)
+
+	hit := (*result.Hits)[0]
+	assert.Equal(t, pointer.Int64(578730123365187705), hit.TextMatch)
+	assert.Equal(t, &api.SearchResultHitTextMatchInfo{
+		BestFieldScore:   pointer.String("1108091339008"),
+		BestFieldWeight:  pointer.Int(15),
+		FieldsMatched:    pointer.Int(1),
+		NumTokensDropped: pointer.Int64(0),
+		Score:            pointer.String("578730123365187705"),
+		TokensMatched:    pointer.Int(1),
+		TypoPrefixScore:  pointer.Int(0),
+	}, hit.TextMatchInfo)
+}
+
+func TestCollectionSearchWithOverrideParams(t *testing.T) {
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:                 pointer.String("text"),
+		QueryBy:           pointer.String("company_name"),
+		EnableOverrides:   pointer.False(),
+		FilterCuratedHits: pointer.True(),
+	}, map[string]string{
+		"enable_overrides":    "false",
+		"filter_curated_hits": "true",
+	})
+}
+
+func TestCollectionSearchQueryStringIsSorted(t *testing.T) {
+	var rawQueries []string
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		rawQueries = append(rawQueries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"found": 0, "hits": []}`))
+	})
+	defer server.Close()
+
+	for i := 0; i < 10; i++ {
+		_, err := client.Collection("companies").Documents().Search(context.Background(), newSearchParams())
+		assert.NoError(t, err)
+	}
+
+	expected := "facet_by=year_started&facet_query=facetQuery&filter_by=num_employees%3A%3D100" +
+		"&group_by=country&group_limit=3&include_fields=company_name&max_facet_values=10" +
+		"&num_typos=2&page=1&per_page=10&prefix=true&q=text&query_by=company_name&sort_by=num_employees%3Adesc"
+	for _, rawQuery := range rawQueries {
+		assert.Equal(t, expected, rawQuery)
+	}
+}
+
+func TestCollectionSearchWithVoiceQuery(t *testing.T) {
+	voiceQuery := base64.StdEncoding.EncodeToString([]byte("RIFF....WAVEfmt "))
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		QueryBy:    pointer.String("company_name"),
+		VoiceQuery: pointer.String(voiceQuery),
+	}, map[string]string{
+		"voice_query": voiceQuery,
+	})
+}
+
+func TestCollectionSearchWithRemoteEmbeddingParams(t *testing.T) {
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:                        pointer.String("text"),
+		QueryBy:                  pointer.String("embedding"),
+		RemoteEmbeddingTimeoutMs: pointer.Int(5000),
+		RemoteEmbeddingNumTries:  pointer.Int(3),
+	}, map[string]string{
+		"remote_embedding_timeout_ms": "5000",
+		"remote_embedding_num_tries":  "3",
+	})
+}
+
+func TestCollectionSearchRaw(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r,
+			"/collections/companies/documents/search?experimental_param=on&q=text&query_by=company_name",
+			http.MethodGet)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"found": 1, "hits": [{"document": {"id": "123"}}]}`))
+	})
+	defer server.Close()
+
+	result, err := client.Collection("companies").Documents().SearchRaw(context.Background(),
+		map[string]string{
+			"q":                  "text",
+			"query_by":           "company_name",
+			"experimental_param": "on",
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *result.Found)
+	assert.Equal(t, map[string]interface{}{"id": "123"}, *(*result.Hits)[0].Document)
+}
+
+func TestCollectionSearchRawOnHttpStatusErrorCodeReturnsError(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"message": "Parameter q is required."}`))
+	})
+	defer server.Close()
+
+	_, err := client.Collection("companies").Documents().SearchRaw(context.Background(),
+		map[string]string{"query_by": "company_name"})
+
+	assert.Equal(t, &HTTPError{Status: http.StatusBadRequest, Body: []byte(`{"message": "Parameter q is required."}`)}, err)
+}
+
+func TestSearchResultMetadataDeserialization(t *testing.T) {
+	inputJSON := `{
+		"found": 0,
+		"hits": [],
+		"metadata": {
+		  "banner": "Summer sale",
+		  "curated": true,
+		  "campaign": {"id": 42}
+		}
+	  }`
+
+	result := &api.SearchResult{}
+	err := json.Unmarshal([]byte(inputJSON), &result)
+	assert.NoError(t, err)
+
+	assert.Equal(t, &map[string]interface{}{
+		"banner":   "Summer sale",
+		"curated":  true,
+		"campaign": map[string]interface{}{"id": float64(42)},
+	}, result.Metadata)
+}
+
+func TestSearchResultWithoutMetadataDeserialization(t *testing.T) {
+	result := &api.SearchResult{}
+	err := json.Unmarshal([]byte(`{"found": 0, "hits": []}`), &result)
+	assert.NoError(t, err)
+	assert.Nil(t, result.Metadata)
+}
+
+func TestSearchResultCuratedHitDeserialization(t *testing.T) {
+	inputJSON := `{
+		"found": 2,
+		"hits": [
+		  {
+			"curated": true,
+			"document": {"id": "124", "company_name": "Stark Industries"},
+			"highlight": {},
+			"highlights": []
+		  },
+		  {
+			"document": {"id": "125", "company_name": "Wayne Enterprises"},
+			"highlight": {},
+			"highlights": [],
+			"text_match": 578730123365187705
+		  }
+		]
+	  }`
+
+	result := &api.SearchResult{}
+	err := json.Unmarshal([]byte(inputJSON), &result)
+	assert.NoError(t, err)
+
+	hits := *result.Hits
+	assert.Equal(t, pointer.True(), hits[0].Curated)
+	assert.Nil(t, hits[1].Curated)
+}
+
+func TestPrefixPerField(t *testing.T) {
+	assert.Equal(t, "true,false,true", api.PrefixPerField([]bool{true, false, true}))
+	assert.Equal(t, "false", api.PrefixPerField([]bool{false}))
+	assert.Equal(t, "", api.PrefixPerField(nil))
+}
+
+func TestCollectionSearchValidatesPrefixPerField(t *testing.T) {
+	tests := []struct {
+		name        string
+		queryBy     string
+		prefix      string
+		expectedErr string
+	}{
+		{name: "single value", queryBy: "company_name,country", prefix: "false"},
+		{name: "value per field", queryBy: "company_name,country", prefix: api.PrefixPerField([]bool{true, false})},
+		{name: "too few values", queryBy: "company_name,country,city", prefix: api.PrefixPerField([]bool{true, false}),
+			expectedErr: "invalid search parameter prefix: 2 values given for 3 query_by fields"},
+		{name: "too many values", queryBy: "company_name", prefix: api.PrefixPerField([]bool{true, false}),
+			expectedErr: "invalid search parameter prefix: 2 values given for 1 query_by fields"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+			params := &api.SearchCollectionParams{
+				Q:       pointer.String("text"),
+				QueryBy: pointer.String(tt.queryBy),
+				Prefix:  pointer.String(tt.prefix),
+			}
+			if tt.expectedErr == "" {
+				mockAPIClient.EXPECT().
+					SearchCollectionWithResponse(gomock.Not(gomock.Nil()), "companies", params).
+					Return(&api.SearchCollectionResponse{JSON200: &api.SearchResult{}}, nil).
+					Times(1)
+			}
+
+			client := NewClient(WithAPIClient(mockAPIClient))
+			_, err := client.Collection("companies").Documents().Search(context.Background(), params)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestNumTyposPerField(t *testing.T) {
+	assert.Equal(t, "2,0,1", api.NumTyposPerField([]int{2, 0, 1}))
+	assert.Equal(t, "1", api.NumTyposPerField([]int{1}))
+	assert.Equal(t, "", api.NumTyposPerField(nil))
+}
+
+func TestCollectionSearchWithNumTyposPerField(t *testing.T) {
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:        pointer.String("XK-2000"),
+		QueryBy:  pointer.String("title,part_number"),
+		NumTypos: pointer.String(api.NumTyposPerField([]int{2, 0})),
+	}, map[string]string{
+		"query_by":  "title,part_number",
+		"num_typos": "2,0",
+	})
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:        pointer.String("XK-2000"),
+		QueryBy:  pointer.String("title,part_number"),
+		NumTypos: pointer.String("1"),
+	}, map[string]string{
+		"num_typos": "1",
+	})
+}
+
+func TestCollectionSearchValidatesNumTyposPerField(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := client.Collection("companies").Documents().Search(context.Background(), &api.SearchCollectionParams{
+		Q:        pointer.String("text"),
+		QueryBy:  pointer.String("company_name,country,city"),
+		NumTypos: pointer.String(api.NumTyposPerField([]int{2, 0})),
+	})
+	assert.EqualError(t, err, "invalid search parameter num_typos: 2 values given for 3 query_by fields")
+}
+
+func TestCollectionSearchWithNestedIncludeExcludeFields(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.RawQuery, "exclude_fields=author.email%2Cauthor.address.street")
+		assert.Contains(t, r.URL.RawQuery, "include_fields=title%2Cauthor.name")
+		assert.Equal(t, "author.email,author.address.street", r.URL.Query().Get("exclude_fields"))
+		assert.Equal(t, "title,author.name", r.URL.Query().Get("include_fields"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"found": 0, "hits": []}`))
+	})
+	defer server.Close()
+
+	_, err := client.Collection("books").Documents().Search(context.Background(), &api.SearchCollectionParams{
+		Q:             pointer.String("text"),
+		QueryBy:       pointer.String("title"),
+		IncludeFields: pointer.String("title,author.name"),
+		ExcludeFields: pointer.String("author.email,author.address.street"),
+	})
+	assert.NoError(t, err)
+}
+
+func TestCollectionSearchFacetOnly(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r,
+			"/collections/companies/documents/search?facet_by=country&page=3&per_page=0&q=%2A",
+			http.MethodGet)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"facet_counts": [
+			  {
+				"field_name": "country",
+				"counts": [
+				  {"count": 120, "highlighted": "USA", "value": "USA"},
+				  {"count": 45, "highlighted": "UK", "value": "UK"}
+				],
+				"stats": {"total_values": 2}
+			  }
+			],
+			"found": 165,
+			"hits": [],
+			"out_of": 165,
+			"page": 3,
+			"search_time_ms": 1
+		  }`))
+	})
+	defer server.Close()
+
+	result, err := client.Collection("companies").Documents().Search(context.Background(), &api.SearchCollectionParams{
+		Q:       pointer.String("*"),
+		FacetBy: pointer.String("country"),
+		Page:    pointer.Int(3),
+		PerPage: pointer.Int(0),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, pointer.Int(165), result.Found)
+	assert.Equal(t, &[]api.SearchResultHit{}, result.Hits)
+	if assert.NotNil(t, result.FacetCounts) && assert.Len(t, *result.FacetCounts, 1) {
+		facet := (*result.FacetCounts)[0]
+		assert.Equal(t, pointer.String("country"), facet.FieldName)
+		assert.Len(t, *facet.Counts, 2)
+		assert.Equal(t, pointer.Int(120), (*facet.Counts)[0].Count)
+		assert.Equal(t, pointer.String("UK"), (*facet.Counts)[1].Value)
+		assert.Equal(t, pointer.Int(2), facet.Stats.TotalValues)
+	}
+}
+
+func TestCollectionSearchAll(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r,
+			"/collections/companies/documents/search?facet_by=country&filter_by=num_employees%3A%3E100&page=2&per_page=20&q=%2A&sort_by=num_employees%3Adesc",
+			http.MethodGet)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"found": 0, "hits": []}`))
+	})
+	defer server.Close()
+
+	params := &api.SearchCollectionParams{
+		FilterBy: pointer.String("num_employees:>100"),
+		SortBy:   pointer.String("num_employees:desc"),
+		FacetBy:  pointer.String("country"),
+		Page:     pointer.Int(2),
+		PerPage:  pointer.Int(20),
+	}
+	_, err := client.Collection("companies").Documents().SearchAll(context.Background(), params)
+	assert.NoError(t, err)
+	assert.Nil(t, params.Q)
+}
+
+func TestCollectionSearchAllWithoutParams(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r, "/collections/companies/documents/search?q=%2A", http.MethodGet)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"found": 0, "hits": []}`))
+	})
+	defer server.Close()
+
+	_, err := client.Collection("companies").Documents().SearchAll(context.Background(), nil)
+	assert.NoError(t, err)
+}
+
+func TestCollectionSearchNoHighlight(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/collections/companies/documents/search", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "stark", query.Get("q"))
+		assert.Equal(t, "company_name", query.Get("query_by"))
+		assert.Equal(t, "none", query.Get("highlight_fields"))
+		assert.Equal(t, "none", query.Get("highlight_full_fields"))
+		assert.False(t, query.Has("snippet_threshold"))
+		assert.Equal(t, "false", query.Get("enable_highlight_v1"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"found": 0, "hits": []}`))
+	})
+	defer server.Close()
+
+	params := &api.SearchCollectionParams{
+		Q:               pointer.String("stark"),
+		QueryBy:         pointer.String("company_name"),
+		HighlightFields: pointer.String("company_name"),
+	}
+	_, err := client.Collection("companies").Documents().SearchNoHighlight(context.Background(), params)
+	assert.NoError(t, err)
+	assert.Equal(t, pointer.String("company_name"), params.HighlightFields)
+	assert.Nil(t, params.SnippetThreshold)
+}
+
+func TestCollectionSearchWithConversationParams(t *testing.T) {
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:                   pointer.String("can you suggest an action series"),
+		QueryBy:             pointer.String("embedding"),
+		Conversation:        pointer.True(),
+		ConversationModelId: pointer.String("conv-model-1"),
+		ConversationId:      pointer.String("123"),
+	}, map[string]string{
+		"conversation":          "true",
+		"conversation_model_id": "conv-model-1",
+		"conversation_id":       "123",
+	})
+}
+
+func TestCollectionSearchContinuesConversation(t *testing.T) {
+	var requests int
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			assert.Equal(t, "", r.URL.Query().Get("conversation_id"))
+		} else {
+			assert.Equal(t, "conv-123", r.URL.Query().Get("conversation_id"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"found": 0,
+			"hits": [],
+			"conversation": {
+			  "answer": "Here are some action series.",
+			  "conversation_history": [{"user": "can you suggest an action series"}],
+			  "conversation_id": "conv-123",
+			  "query": "can you suggest an action series"
+			}
+		  }`))
+	})
+	defer server.Close()
+
+	params := &api.SearchCollectionParams{
+		Q:                   pointer.String("can you suggest an action series"),
+		QueryBy:             pointer.String("embedding"),
+		Conversation:        pointer.True(),
+		ConversationModelId: pointer.String("conv-model-1"),
+	}
+	result, err := client.Collection("shows").Documents().Search(context.Background(), params)
+	assert.NoError(t, err)
+	assert.Equal(t, &api.SearchResultConversation{
+		Answer:              "Here are some action series.",
+		ConversationHistory: []map[string]interface{}{{"user": "can you suggest an action series"}},
+		ConversationId:      "conv-123",
+		Query:               "can you suggest an action series",
+	}, result.Conversation)
+
+	params.ConversationId = &result.Conversation.ConversationId
+	_, err = client.Collection("shows").Documents().Search(context.Background(), params)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+func TestCollectionSearchWithMaxFilterByCandidates(t *testing.T) {
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:                     pointer.String("text"),
+		QueryBy:               pointer.String("company_name"),
+		FilterBy:              pointer.String("company_name:Acm*"),
+		MaxFilterByCandidates: pointer.Int(20),
+	}, map[string]string{
+		"filter_by":                "company_name:Acm*",
+		"max_filter_by_candidates": "20",
+	})
+}
+
+func TestCollectionSearchWithReferenceFilter(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "$Customers(customer_id:=customer_a && order_count:>2)", r.URL.Query().Get("filter_by"))
+		assert.Equal(t, "product_name,$Customers(customer_name,order_count)", r.URL.Query().Get("include_fields"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"found": 1,
+			"hits": [
+			  {
+				"document": {
+				  "id": "1",
+				  "product_name": "Shoes",
+				  "Customers": {
+					"customer_name": "Joe",
+					"order_count": 3
+				  }
+				},
+				"highlight": {},
+				"highlights": []
+			  }
+			]
+		  }`))
+	})
+	defer server.Close()
+
+	result, err := client.Collection("products").Documents().Search(context.Background(), &api.SearchCollectionParams{
+		Q:             pointer.String("*"),
+		FilterBy:      pointer.String("$Customers(customer_id:=customer_a && order_count:>2)"),
+		IncludeFields: pointer.String("product_name,$Customers(customer_name,order_count)"),
+	})
+
+	assert.NoError(t, err)
+	document := *(*result.Hits)[0].Document
+	assert.Equal(t, "Shoes", document["product_name"])
+	assert.Equal(t, map[string]interface{}{
+		"customer_name": "Joe",
+		"order_count":   float64(3),
+	}, document["Customers"])
+}
+
+func TestCollectionSearchWithAliasedReferenceIncludeFields(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.RawQuery, "include_fields=product_name%2C%24Customers%28customer_name%29+as+customer")
+		assert.Equal(t, "product_name,$Customers(customer_name) as customer", r.URL.Query().Get("include_fields"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"found": 1,
+			"hits": [
+			  {
+				"document": {
+				  "id": "1",
+				  "product_name": "Shoes",
+				  "customer": {"customer_name": "Joe"}
+				},
+				"highlight": {},
+				"highlights": []
+			  }
+			]
+		  }`))
+	})
+	defer server.Close()
+
+	result, err := client.Collection("products").Documents().Search(context.Background(), &api.SearchCollectionParams{
+		Q:             pointer.String("*"),
+		FilterBy:      pointer.String("$Customers(customer_id:=customer_a)"),
+		IncludeFields: pointer.String("product_name,$Customers(customer_name) as customer"),
+	})
+
+	assert.NoError(t, err)
+	document := *(*result.Hits)[0].Document
+	assert.Equal(t, map[string]interface{}{"customer_name": "Joe"}, document["customer"])
+	assert.NotContains(t, document, "Customers")
+}
+
+func TestDocumentRetrieveDecodesAliasedReferenceFields(t *testing.T) {
+	type customer struct {
+		Name string `json:"customer_name"`
+	}
+	type product struct {
+		ID          string   `json:"id"`
+		ProductName string   `json:"product_name"`
+		Customer    customer `json:"customer"`
+	}
+
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "product_name,$Customers(customer_name) as customer", r.URL.Query().Get("include_fields"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "1", "product_name": "Shoes", "customer": {"customer_name": "Joe"}}`))
+	})
+	defer server.Close()
+
+	result, err := GenericCollection[product](client, "products").Document("1").RetrieveWithParams(context.Background(),
+		&api.GetDocumentParams{IncludeFields: pointer.String("product_name,$Customers(customer_name) as customer")})
+
+	assert.NoError(t, err)
+	assert.Equal(t, product{ID: "1", ProductName: "Shoes", Customer: customer{Name: "Joe"}}, result)
+}
+
+func TestCollectionSearchWithStopwords(t *testing.T) {
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:         pointer.String("the stark industries"),
+		QueryBy:   pointer.String("company_name"),
+		Stopwords: pointer.String("stopword_set1"),
+	}, map[string]string{
+		"q":         "the stark industries",
+		"stopwords": "stopword_set1",
+	})
+}
+
+func TestCollectionSearchWithPreSegmentedQueryAndInfix(t *testing.T) {
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:                 pointer.String("东京 大学"),
+		QueryBy:           pointer.String("title,description"),
+		PreSegmentedQuery: pointer.True(),
+		Infix:             pointer.String("always,off"),
+	}, map[string]string{
+		"q":                   "东京 大学",
+		"query_by":            "title,description",
+		"pre_segmented_query": "true",
+		"infix":               "always,off",
+	})
+}
+
+func TestCollectionSearchWithDropTokensMode(t *testing.T) {
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:                   pointer.String("stark industries new york"),
+		QueryBy:             pointer.String("company_name"),
+		DropTokensThreshold: pointer.Int(1),
+		DropTokensMode:      pointer.String("both_sides:3"),
+	}, map[string]string{
+		"drop_tokens_threshold": "1",
+		"drop_tokens_mode":      "both_sides:3",
+	})
+}
+
+func TestCollectionSearchWithHybridParams(t *testing.T) {
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:                   pointer.String("stark"),
+		QueryBy:             pointer.String("company_name,embedding"),
+		VectorQuery:         pointer.String("embedding:([], alpha: 0.8)"),
+		TextMatchType:       pointer.String("max_weight"),
+		RerankHybridMatches: pointer.True(),
+	}, map[string]string{
+		"vector_query":          "embedding:([], alpha: 0.8)",
+		"text_match_type":       "max_weight",
+		"rerank_hybrid_matches": "true",
+	})
+}
+
+func TestCollectionSearchWithTypoTokenParams(t *testing.T) {
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:                                  pointer.String("XK-2000"),
+		QueryBy:                            pointer.String("part_number"),
+		EnableTyposForNumericalTokens:      pointer.False(),
+		EnableTyposForAlphaNumericalTokens: pointer.False(),
+	}, map[string]string{
+		"enable_typos_for_numerical_tokens":       "false",
+		"enable_typos_for_alpha_numerical_tokens": "false",
+	})
+}
+
+func TestCollectionSearchWithEnableLazyFilter(t *testing.T) {
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:                pointer.String("*"),
+		FilterBy:         pointer.String("num_employees:>100 && country:[USA, UK]"),
+		EnableLazyFilter: pointer.True(),
+	}, map[string]string{
+		"filter_by":          "num_employees:>100 && country:[USA, UK]",
+		"enable_lazy_filter": "true",
+	})
+}
+
+func TestCollectionSearchWithOverrideTagsAndSynonymSets(t *testing.T) {
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:            pointer.String("phone"),
+		QueryBy:      pointer.String("name"),
+		OverrideTags: pointer.String("black_friday,mobile"),
+		SynonymSets:  pointer.String("electronics,brands"),
+	}, map[string]string{
+		"override_tags": "black_friday,mobile",
+		"synonym_sets":  "electronics,brands",
+	})
+}
+
+func TestCollectionSearchWithNlQuery(t *testing.T) {
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:         pointer.String("running shoes under 100 dollars sorted by rating"),
+		QueryBy:   pointer.String("name,description"),
+		NlQuery:   pointer.True(),
+		NlModelId: pointer.String("gemini-model"),
+	}, map[string]string{
+		"nl_query":    "true",
+		"nl_model_id": "gemini-model",
+	})
+}
+
+func TestCollectionSearchWithFacetByBuilder(t *testing.T) {
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:       pointer.String("*"),
+		FacetBy: pointer.String(api.FacetBy().Field("country").SortAlpha(api.SortAsc).Range("num_employees", api.FacetRange{Label: "small", To: pointer.Float64(100)}).String()),
+	}, map[string]string{
+		"facet_by": "country(sort_by: _alpha:asc),num_employees(small:[, 100])",
+	})
+}
+
+func TestCollectionSearchWithSortByBuilder(t *testing.T) {
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:      pointer.String("*"),
+		SortBy: pointer.String(api.SortBy().Field("num_employees", api.SortDesc).MissingValues(api.MissingValuesLast).Field("company_name", api.SortAsc).String()),
+	}, map[string]string{
+		"sort_by": "num_employees(missing_values: last):desc,company_name:asc",
+	})
+}
+
+func TestCollectionSearchWithFilterf(t *testing.T) {
+	filterBy, err := api.Filterf("country:=%s && num_employees:>%d", "USA && num_employees:>0", 100)
+	assert.NoError(t, err)
+	assertSearchQueryEncoding(t, &api.SearchCollectionParams{
+		Q:        pointer.String("*"),
+		FilterBy: pointer.String(filterBy),
+	}, map[string]string{
+		"filter_by": "country:=`USA && num_employees:>0` && num_employees:>100",
+	})
+}
+
+func TestCollectionSearchGroupedByMultipleFields(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "brand,size", r.URL.Query().Get("group_by"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"found": 2,
+			"grouped_hits": [
+				{"found": 3, "group_key": ["Nike", 42], "hits": [{"document": {"id": "1"}}]},
+				{"found": 1, "group_key": [["Adidas", "Puma"], 43], "hits": [{"document": {"id": "2"}}]}
+			]
+		}`))
+	})
+	defer server.Close()
+
+	result, err := client.Collection("shoes").Documents().Search(context.Background(), &api.SearchCollectionParams{
+		Q:          pointer.String("*"),
+		GroupBy:    pointer.String(api.GroupBy("brand", "size")),
+		GroupLimit: pointer.Int(1),
+	})
+	assert.NoError(t, err)
+	if assert.NotNil(t, result.GroupedHits) && assert.Len(t, *result.GroupedHits, 2) {
+		groups := *result.GroupedHits
+		assert.Equal(t, []interface{}{"Nike", float64(42)}, groups[0].GroupKey)
+		assert.Equal(t, pointer.Int(3), groups[0].Found)
+		assert.Equal(t, []interface{}{[]interface{}{"Adidas", "Puma"}, float64(43)}, groups[1].GroupKey)
+		assert.Len(t, groups[1].Hits, 1)
+	}
+}
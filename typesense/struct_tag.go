@@ -0,0 +1,138 @@
+package typesense
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// decodeDocument decodes the JSON document read from r into v, mapping the document
+// fields to the struct fields by the given struct tag. An empty tag uses the json tags.
+func decodeDocument(r io.Reader, v any, tag string) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return unmarshalDocument(data, v, tag)
+}
+
+func unmarshalDocument(data []byte, v any, tag string) error {
+	if tag == "" || tag == "json" {
+		return json.Unmarshal(data, v)
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("failed to decode document into non-pointer %T", v)
+	}
+	return unmarshalTagged(data, rv.Elem(), tag)
+}
+
+// unmarshalTagged decodes data into rv. Structs, pointers to structs and slices of
+// them are mapped by tag; other values, e.g. maps and interfaces, are decoded with
+// encoding/json, so that structs nested in them are mapped by their json tags.
+func unmarshalTagged(data []byte, rv reflect.Value, tag string) error {
+	if rv.Addr().Type().Implements(jsonUnmarshalerType) {
+		return json.Unmarshal(data, rv.Addr().Interface())
+	}
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if string(bytes.TrimSpace(data)) == "null" {
+			rv.Set(reflect.Zero(rv.Type()))
+			return nil
+		}
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return unmarshalTagged(data, rv.Elem(), tag)
+	case reflect.Slice:
+		var elems []json.RawMessage
+		if err := json.Unmarshal(data, &elems); err != nil {
+			return err
+		}
+		if elems == nil {
+			rv.Set(reflect.Zero(rv.Type()))
+			return nil
+		}
+		slice := reflect.MakeSlice(rv.Type(), len(elems), len(elems))
+		for i, elem := range elems {
+			if err := unmarshalTagged(elem, slice.Index(i), tag); err != nil {
+				return err
+			}
+		}
+		rv.Set(slice)
+		return nil
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return err
+		}
+		_, err := unmarshalTaggedFields(fields, rv, tag)
+		return err
+	default:
+		return json.Unmarshal(data, rv.Addr().Interface())
+	}
+}
+
+// unmarshalTaggedFields sets the fields of the struct rv from the document fields
+// named by their tag, or by the field name if the tag is missing, and reports
+// whether any field was set. Embedded structs and pointers to structs without a
+// tag are flattened, like encoding/json does: a nil embedded pointer is only
+// allocated when one of its fields is present in the document.
+func unmarshalTaggedFields(fields map[string]json.RawMessage, rv reflect.Value, tag string) (bool, error) {
+	t := rv.Type()
+	decoded := false
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			ok, err := unmarshalTaggedFields(fields, rv.Field(i), tag)
+			if err != nil {
+				return false, err
+			}
+			decoded = decoded || ok
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Ptr && field.Type.Elem().Kind() == reflect.Struct {
+			embedded := rv.Field(i)
+			if embedded.IsNil() {
+				// pointers to unexported structs can not be allocated
+				if !embedded.CanSet() {
+					continue
+				}
+				embedded = reflect.New(field.Type.Elem())
+			}
+			ok, err := unmarshalTaggedFields(fields, embedded.Elem(), tag)
+			if err != nil {
+				return false, err
+			}
+			if ok && rv.Field(i).IsNil() {
+				rv.Field(i).Set(embedded)
+			}
+			decoded = decoded || ok
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		data, ok := fields[name]
+		if !ok {
+			continue
+		}
+		if err := unmarshalTagged(data, rv.Field(i), tag); err != nil {
+			return false, fmt.Errorf("failed to decode field %s: %w", name, err)
+		}
+		decoded = true
+	}
+	return decoded, nil
+}
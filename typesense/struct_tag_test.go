@@ -0,0 +1,141 @@
+package typesense
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/typesense/typesense-go/v2/typesense/api"
+	"github.com/typesense/typesense-go/v2/typesense/api/pointer"
+)
+
+type taggedAddress struct {
+	City    string `typesense:"city" json:"town"`
+	Country string
+}
+
+type taggedAudit struct {
+	UpdatedAt time.Time `typesense:"updated_at"`
+}
+
+type taggedCompany struct {
+	taggedAudit
+	ID           string            `db:"company_id" typesense:"id"`
+	CompanyName  string            `db:"name" typesense:"company_name,omitempty"`
+	NumEmployees int64             `db:"employees" typesense:"num_employees"`
+	Address      *taggedAddress    `typesense:"address"`
+	Offices      []taggedAddress   `typesense:"offices"`
+	Tags         map[string]string `typesense:"tags"`
+	Internal     string            `typesense:"-"`
+	private      string
+}
+
+func TestUnmarshalDocumentWithStructTag(t *testing.T) {
+	data := []byte(`{
+		"id": "124",
+		"company_name": "Stark Industries",
+		"num_employees": 5215,
+		"address": {"city": "New York", "Country": "USA", "town": "ignored"},
+		"offices": [{"city": "Malibu"}, {"city": "London", "Country": "UK"}],
+		"tags": {"sector": "defense"},
+		"updated_at": "2024-01-02T03:04:05Z",
+		"-": "ignored",
+		"Internal": "ignored",
+		"private": "ignored"
+	}`)
+
+	var company taggedCompany
+	assert.NoError(t, unmarshalDocument(data, &company, "typesense"))
+	assert.Equal(t, taggedCompany{
+		taggedAudit:  taggedAudit{UpdatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		ID:           "124",
+		CompanyName:  "Stark Industries",
+		NumEmployees: 5215,
+		Address:      &taggedAddress{City: "New York", Country: "USA"},
+		Offices:      []taggedAddress{{City: "Malibu"}, {City: "London", Country: "UK"}},
+		Tags:         map[string]string{"sector": "defense"},
+	}, company)
+
+	company = taggedCompany{}
+	assert.NoError(t, unmarshalDocument([]byte(`{"company_id": "124", "name": "Stark Industries", "address": null}`), &company, "db"))
+	assert.Equal(t, taggedCompany{ID: "124", CompanyName: "Stark Industries"}, company)
+}
+
+func TestUnmarshalDocumentWithEmbeddedPointer(t *testing.T) {
+	// the embedded type is exported so that the nil pointer can be allocated
+	type Audit struct {
+		UpdatedAt time.Time `typesense:"updated_at"`
+	}
+	type company struct {
+		*Audit
+		*taggedAudit
+		ID string `typesense:"id"`
+	}
+
+	var doc company
+	assert.NoError(t, unmarshalDocument([]byte(`{"id": "124", "updated_at": "2024-01-02T03:04:05Z"}`), &doc, "typesense"))
+	assert.Equal(t, company{Audit: &Audit{UpdatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}, ID: "124"}, doc)
+
+	doc = company{}
+	assert.NoError(t, unmarshalDocument([]byte(`{"id": "124"}`), &doc, "typesense"))
+	assert.Equal(t, company{ID: "124"}, doc)
+
+	doc = company{taggedAudit: &taggedAudit{}}
+	assert.NoError(t, unmarshalDocument([]byte(`{"updated_at": "2024-01-02T03:04:05Z"}`), &doc, "typesense"))
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), doc.taggedAudit.UpdatedAt)
+}
+
+func TestUnmarshalDocumentWithJSONTag(t *testing.T) {
+	var address taggedAddress
+	assert.NoError(t, unmarshalDocument([]byte(`{"city": "New York", "town": "Brooklyn"}`), &address, ""))
+	assert.Equal(t, taggedAddress{City: "Brooklyn"}, address)
+}
+
+func TestUnmarshalDocumentWithTypeMismatchReturnsError(t *testing.T) {
+	var company taggedCompany
+	err := unmarshalDocument([]byte(`{"num_employees": "many"}`), &company, "typesense")
+	assert.ErrorContains(t, err, "failed to decode field num_employees")
+
+	assert.Error(t, unmarshalDocument([]byte(`{}`), company, "typesense"))
+}
+
+func TestDocumentRetrieveWithStructTag(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r, "/collections/companies/documents/124", http.MethodGet)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "124", "company_name": "Stark Industries", "num_employees": 5215}`))
+	})
+	defer server.Close()
+
+	client = client.Clone(WithStructTag("typesense"))
+	company, err := GenericCollection[taggedCompany](client, "companies").Document("124").Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, taggedCompany{ID: "124", CompanyName: "Stark Industries", NumEmployees: 5215}, company)
+}
+
+func TestSearchTypedWithStructTag(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"found": 2,
+			"hits": [
+			  {"document": {"id": "124", "company_name": "Stark Industries", "num_employees": 5215}, "text_match": 100},
+			  {"text_match": 50}
+			]
+		  }`))
+	})
+	defer server.Close()
+
+	client = client.Clone(WithStructTag("typesense"))
+	result, err := SearchTyped[taggedCompany](context.Background(), client, "companies",
+		&api.SearchCollectionParams{Q: pointer.String("stark"), QueryBy: pointer.String("company_name")})
+	assert.NoError(t, err)
+	assert.Equal(t, pointer.Int(2), result.Found)
+	if assert.Len(t, result.Hits, 2) {
+		assert.Equal(t, taggedCompany{ID: "124", CompanyName: "Stark Industries", NumEmployees: 5215}, result.Hits[0].Document)
+		assert.Equal(t, pointer.Int64(100), result.Hits[0].TextMatch)
+		assert.Equal(t, taggedCompany{}, result.Hits[1].Document)
+	}
+}
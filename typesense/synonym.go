@@ -22,6 +22,9 @@ type synonym struct {
 }
 
 func (s *synonym) Retrieve(ctx context.Context) (*api.SearchSynonym, error) {
+	if err := validateCollectionName(s.collectionName); err != nil {
+		return nil, err
+	}
 	response, err := s.apiClient.GetSearchSynonymWithResponse(ctx,
 		s.collectionName, s.synonymID)
 	if err != nil {
@@ -34,6 +37,9 @@ func (s *synonym) Retrieve(ctx context.Context) (*api.SearchSynonym, error) {
 }
 
 func (s *synonym) Delete(ctx context.Context) (*api.SearchSynonym, error) {
+	if err := validateCollectionName(s.collectionName); err != nil {
+		return nil, err
+	}
 	response, err := s.apiClient.DeleteSearchSynonymWithResponse(ctx,
 		s.collectionName, s.synonymID)
 	if err != nil {
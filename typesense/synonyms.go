@@ -21,6 +21,9 @@ type synonyms struct {
 }
 
 func (s *synonyms) Upsert(ctx context.Context, synonymID string, synonymSchema *api.SearchSynonymSchema) (*api.SearchSynonym, error) {
+	if err := validateCollectionName(s.collectionName); err != nil {
+		return nil, err
+	}
 	response, err := s.apiClient.UpsertSearchSynonymWithResponse(ctx,
 		s.collectionName, synonymID, api.UpsertSearchSynonymJSONRequestBody(*synonymSchema))
 	if err != nil {
@@ -33,6 +36,9 @@ func (s *synonyms) Upsert(ctx context.Context, synonymID string, synonymSchema *
 }
 
 func (s *synonyms) Retrieve(ctx context.Context) ([]*api.SearchSynonym, error) {
+	if err := validateCollectionName(s.collectionName); err != nil {
+		return nil, err
+	}
 	response, err := s.apiClient.GetSearchSynonymsWithResponse(ctx, s.collectionName)
 	if err != nil {
 		return nil, err
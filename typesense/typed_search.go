@@ -0,0 +1,52 @@
+package typesense
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/typesense/typesense-go/v2/typesense/api"
+)
+
+// TypedSearchHit is a search hit with its document decoded into T. Highlights
+// reference the document fields by their names in the collection schema.
+type TypedSearchHit[T any] struct {
+	api.SearchResultHit
+	Document T `json:"document"`
+}
+
+// TypedSearchResult is a search result with the hit documents decoded into T.
+// The other attributes of the result, like facet counts, are those of api.SearchResult.
+type TypedSearchResult[T any] struct {
+	api.SearchResult
+	Hits []TypedSearchHit[T] `json:"hits"`
+}
+
+// SearchTyped performs document search in the collection and decodes the
+// documents of the hits into T, e.g.
+//
+//	result, err := SearchTyped[Company](ctx, client, "companies", params)
+func SearchTyped[T any](ctx context.Context, c *Client, collectionName string, params *api.SearchCollectionParams) (*TypedSearchResult[T], error) {
+	d := &documents{apiClient: c.apiClient, collectionName: collectionName, schemaCache: c.schemaCache}
+	response, err := d.search(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	raw := &TypedSearchResult[json.RawMessage]{}
+	if err := json.Unmarshal(response.Body, raw); err != nil {
+		return nil, err
+	}
+	result := &TypedSearchResult[T]{SearchResult: raw.SearchResult}
+	if raw.Hits != nil {
+		result.Hits = make([]TypedSearchHit[T], len(raw.Hits))
+	}
+	for i, hit := range raw.Hits {
+		result.Hits[i].SearchResultHit = hit.SearchResultHit
+		if len(hit.Document) == 0 {
+			continue
+		}
+		if err := unmarshalDocument(hit.Document, &result.Hits[i].Document, c.apiConfig.StructTag); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
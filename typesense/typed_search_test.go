@@ -0,0 +1,104 @@
+package typesense
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/typesense/typesense-go/v2/typesense/api"
+	"github.com/typesense/typesense-go/v2/typesense/api/pointer"
+	"github.com/typesense/typesense-go/v2/typesense/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+type typedSearchCompany struct {
+	ID           string `json:"id"`
+	CompanyName  string `json:"company_name"`
+	NumEmployees int    `json:"num_employees"`
+	Country      string `json:"country"`
+}
+
+func TestSearchTyped(t *testing.T) {
+	server, client := newTestServerAndClient(func(w http.ResponseWriter, r *http.Request) {
+		validateRequestMetadata(t, r,
+			"/collections/companies/documents/search?facet_by=country&q=stark&query_by=company_name", http.MethodGet)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"facet_counts": [
+			  {"field_name": "country", "counts": [{"count": 1, "highlighted": "USA", "value": "USA"}]}
+			],
+			"found": 1,
+			"out_of": 125,
+			"page": 1,
+			"search_time_ms": 1,
+			"hits": [
+			  {
+				"document": {"id": "124", "company_name": "Stark Industries", "num_employees": 5215, "country": "USA"},
+				"highlight": {
+				  "company_name": {"matched_tokens": ["Stark"], "snippet": "<mark>Stark</mark> Industries"}
+				},
+				"highlights": [
+				  {"field": "company_name", "matched_tokens": ["Stark"], "snippet": "<mark>Stark</mark> Industries"}
+				],
+				"text_match": 578730123365187705
+			  }
+			]
+		  }`))
+	})
+	defer server.Close()
+
+	result, err := SearchTyped[typedSearchCompany](context.Background(), client, "companies", &api.SearchCollectionParams{
+		Q:       pointer.String("stark"),
+		QueryBy: pointer.String("company_name"),
+		FacetBy: pointer.String("country"),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *result.Found)
+	assert.Equal(t, "country", *(*result.FacetCounts)[0].FieldName)
+	assert.Len(t, result.Hits, 1)
+
+	hit := result.Hits[0]
+	assert.Equal(t, typedSearchCompany{ID: "124", CompanyName: "Stark Industries", NumEmployees: 5215, Country: "USA"}, hit.Document)
+	assert.Equal(t, int64(578730123365187705), *hit.TextMatch)
+	assert.Equal(t, "company_name", *(*hit.Highlights)[0].Field)
+	assert.Contains(t, *hit.Highlight, "company_name")
+	highlight, ok := hit.HighlightField("company_name")
+	assert.True(t, ok)
+	assert.Equal(t, "<mark>Stark</mark> Industries", *highlight.Snippet)
+}
+
+func TestSearchTypedOnHttpStatusErrorCodeReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	params := &api.SearchCollectionParams{Q: pointer.String("stark"), QueryBy: pointer.String("company_name")}
+	mockAPIClient.EXPECT().
+		SearchCollectionWithResponse(gomock.Not(gomock.Nil()), "companies", params).
+		Return(&api.SearchCollectionResponse{
+			HTTPResponse: &http.Response{
+				StatusCode: 404,
+			},
+			Body: []byte("Not Found"),
+		}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := SearchTyped[typedSearchCompany](context.Background(), client, "companies", params)
+	assert.Equal(t, &HTTPError{Status: 404, Body: []byte("Not Found")}, err)
+}
+
+func TestSearchTypedValidatesParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	_, err := SearchTyped[typedSearchCompany](context.Background(), client, "companies", &api.SearchCollectionParams{
+		Q:    pointer.String("stark"),
+		Page: pointer.Int(-1),
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,58 @@
+package typesense
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is the current version of the typesense-go client.
+const Version = "2.0.0"
+
+// ServerVersion is the Typesense server version this client is built against.
+const ServerVersion = "30.0"
+
+// CheckServerCompatibility fetches the server version from the debug endpoint and
+// compares its major version with ServerVersion. It returns false when the major
+// versions differ, which indicates that some features of the client may not be
+// supported by the server (or vice versa); the mismatch is also reported to the
+// handler set with WithWarningHandler.
+func (c *Client) CheckServerCompatibility(ctx context.Context) (bool, error) {
+	response, err := c.apiClient.DebugWithResponse(ctx)
+	if err != nil {
+		return false, err
+	}
+	if response.JSON200 == nil {
+		return false, &HTTPError{Status: response.StatusCode(), Body: response.Body}
+	}
+	if response.JSON200.Version == nil {
+		return false, errors.New("server did not report its version")
+	}
+	serverMajor, err := majorVersion(*response.JSON200.Version)
+	if err != nil {
+		return false, err
+	}
+	clientMajor, err := majorVersion(ServerVersion)
+	if err != nil {
+		return false, err
+	}
+	if serverMajor != clientMajor {
+		if c.apiConfig.WarningHandler != nil {
+			c.apiConfig.WarningHandler(fmt.Sprintf("server version %s does not match version %s the client is built against",
+				*response.JSON200.Version, ServerVersion))
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+func majorVersion(version string) (int, error) {
+	major, _, _ := strings.Cut(strings.TrimPrefix(version, "v"), ".")
+	n, err := strconv.Atoi(major)
+	if err != nil {
+		return 0, fmt.Errorf("invalid version %q", version)
+	}
+	return n, nil
+}
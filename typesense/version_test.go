@@ -0,0 +1,131 @@
+package typesense
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/typesense/typesense-go/v2/typesense/api"
+	"github.com/typesense/typesense-go/v2/typesense/api/pointer"
+	"github.com/typesense/typesense-go/v2/typesense/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func newDebugResponse(version *string) *api.DebugResponse {
+	return &api.DebugResponse{
+		JSON200: &struct {
+			Version *string `json:"version,omitempty"`
+		}{Version: version},
+	}
+}
+
+func TestCheckServerCompatibility(t *testing.T) {
+	tests := []struct {
+		name          string
+		serverVersion string
+		compatible    bool
+	}{
+		{name: "same version", serverVersion: ServerVersion, compatible: true},
+		{name: "same major version", serverVersion: "30.1", compatible: true},
+		{name: "prefixed version", serverVersion: "v30.0", compatible: true},
+		{name: "older major version", serverVersion: "29.0", compatible: false},
+		{name: "legacy version", serverVersion: "0.25.2", compatible: false},
+		{name: "newer major version", serverVersion: "31.0", compatible: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+			mockAPIClient.EXPECT().
+				DebugWithResponse(gomock.Not(gomock.Nil())).
+				Return(newDebugResponse(pointer.String(tt.serverVersion)), nil).
+				Times(1)
+
+			var warnings []string
+			client := NewClient(WithAPIClient(mockAPIClient), WithWarningHandler(func(warning string) {
+				warnings = append(warnings, warning)
+			}))
+			result, err := client.CheckServerCompatibility(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.compatible, result)
+			if tt.compatible {
+				assert.Empty(t, warnings)
+			} else {
+				assert.Equal(t, []string{"server version " + tt.serverVersion + " does not match version " + ServerVersion + " the client is built against"}, warnings)
+			}
+		})
+	}
+}
+
+func TestCheckServerCompatibilityOnInvalidVersionReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		DebugWithResponse(gomock.Not(gomock.Nil())).
+		Return(newDebugResponse(pointer.String("nightly")), nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	result, err := client.CheckServerCompatibility(context.Background())
+	assert.Error(t, err)
+	assert.False(t, result)
+}
+
+func TestCheckServerCompatibilityOnMissingVersionReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		DebugWithResponse(gomock.Not(gomock.Nil())).
+		Return(newDebugResponse(nil), nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	result, err := client.CheckServerCompatibility(context.Background())
+	assert.Error(t, err)
+	assert.False(t, result)
+}
+
+func TestCheckServerCompatibilityOnApiClientErrorReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		DebugWithResponse(gomock.Not(gomock.Nil())).
+		Return(nil, errors.New("failed request")).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	result, err := client.CheckServerCompatibility(context.Background())
+	assert.Error(t, err)
+	assert.False(t, result)
+}
+
+func TestCheckServerCompatibilityOnHttpStatusErrorCodeReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAPIClient := mocks.NewMockAPIClientInterface(ctrl)
+
+	mockAPIClient.EXPECT().
+		DebugWithResponse(gomock.Not(gomock.Nil())).
+		Return(&api.DebugResponse{
+			HTTPResponse: &http.Response{
+				StatusCode: 500,
+			},
+			Body: []byte("Internal Server error"),
+		}, nil).
+		Times(1)
+
+	client := NewClient(WithAPIClient(mockAPIClient))
+	result, err := client.CheckServerCompatibility(context.Background())
+	assert.Error(t, err)
+	assert.False(t, result)
+}